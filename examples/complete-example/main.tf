@@ -23,6 +23,9 @@ data "pinecone_projects" "all" {}
 # Create a test project
 resource "pinecone_project" "test" {
   name = "terraform-test-project"
+
+  # The API key below lives in this project, so destroying the project deletes it too.
+  force_destroy = true
 }
 
 # Read the created project using data source
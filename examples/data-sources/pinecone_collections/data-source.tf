@@ -11,3 +11,8 @@ provider "pinecone" {}
 data "pinecone_collections" "test" {
 }
 
+
+data "pinecone_collections" "ready" {
+  status    = "Ready"
+  dimension = 1536
+}
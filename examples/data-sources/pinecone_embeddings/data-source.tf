@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    pinecone = {
+      source = "pinecone-io/pinecone"
+    }
+  }
+}
+
+provider "pinecone" {}
+
+data "pinecone_embeddings" "example" {
+  model      = "multilingual-e5-large"
+  input_type = "passage"
+  truncate   = "END"
+  inputs = [
+    "Apple is a popular fruit known for its sweetness and crisp texture.",
+    "The tech company Apple is known for its innovative products like the iPhone.",
+  ]
+}
+
+output "first_embedding" {
+  value = data.pinecone_embeddings.example.embeddings[0].values
+}
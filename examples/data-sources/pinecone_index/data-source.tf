@@ -23,3 +23,10 @@ resource "pinecone_index" "test" {
 data "pinecone_index" "test" {
   name = pinecone_index.test.name
 }
+
+data "pinecone_index" "shared" {
+  tags = {
+    team = "search"
+    env  = "prod"
+  }
+}
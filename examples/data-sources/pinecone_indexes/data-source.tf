@@ -21,4 +21,16 @@ resource "pinecone_index" "test" {
 }
 
 data "pinecone_indexes" "test" {
-}
\ No newline at end of file
+}
+data "pinecone_indexes" "prod_serverless" {
+  name_prefix = "prod-"
+  spec_type   = "serverless"
+  ready_only  = true
+  tags = {
+    environment = "production"
+  }
+}
+
+output "prod_serverless_index_names" {
+  value = data.pinecone_indexes.prod_serverless.names
+}
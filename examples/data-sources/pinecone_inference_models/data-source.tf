@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    pinecone = {
+      source = "pinecone-io/pinecone"
+    }
+  }
+}
+
+provider "pinecone" {}
+
+data "pinecone_inference_models" "dense" {
+  type        = "embed"
+  vector_type = "dense"
+}
+
+output "dense_embedding_models" {
+  value = [for m in data.pinecone_inference_models.dense.models : m.name]
+}
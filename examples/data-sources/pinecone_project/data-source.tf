@@ -16,6 +16,11 @@ data "pinecone_project" "example" {
   id = var.project_id
 }
 
+# Read a project by its name
+data "pinecone_project" "by_name" {
+  name = "search-prod"
+}
+
 # Output the project details
 output "project_name" {
   description = "The name of the project"
@@ -32,6 +32,13 @@ output "project_ids" {
   value       = [for project in data.pinecone_projects.all.projects : project.id]
 }
 
+# Read only the production projects created since 2025 that enforce CMEK encryption
+data "pinecone_projects" "prod_cmek" {
+  name_regex                 = "^prod-"
+  created_after              = "2025-01-01T00:00:00Z"
+  force_encryption_with_cmek = true
+}
+
 # Output projects with CMEK encryption enabled
 output "cmek_projects" {
   description = "Projects with CMEK encryption enabled"
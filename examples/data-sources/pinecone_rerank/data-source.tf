@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    pinecone = {
+      source = "pinecone-io/pinecone"
+    }
+  }
+}
+
+provider "pinecone" {}
+
+data "pinecone_rerank" "canary" {
+  model = "bge-reranker-v2-m3"
+  query = "The tech company Apple is known for its innovative products like the iPhone."
+  top_n = 2
+  documents = [
+    { text = "Apple is a popular fruit known for its sweetness and crisp texture." },
+    { text = "Apple's iPhone set the standard for modern smartphones." },
+    { text = "Many people enjoy eating apples as a healthy snack." },
+  ]
+}
+
+check "reranker_canary" {
+  assert {
+    condition     = data.pinecone_rerank.canary.results[0].index == 1
+    error_message = "The reranker did not rank the iPhone document first."
+  }
+}
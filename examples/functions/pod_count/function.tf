@@ -0,0 +1,35 @@
+terraform {
+  required_version = ">= 1.8.0"
+  required_providers {
+    pinecone = {
+      source = "pinecone-io/pinecone"
+    }
+  }
+}
+
+provider "pinecone" {}
+
+resource "pinecone_project" "example" {
+  name     = "example-project"
+  max_pods = 12
+}
+
+resource "pinecone_index" "example" {
+  name      = "example-index"
+  dimension = 1536
+  spec = {
+    pod = {
+      environment = "us-west4-gcp"
+      pod_type    = "p1.x2"
+      shards      = 2
+      replicas    = 3
+    }
+  }
+
+  lifecycle {
+    precondition {
+      condition     = provider::pinecone::pod_count("p1.x2", 2, 3) <= pinecone_project.example.max_pods
+      error_message = "The index needs more pods than the project allows."
+    }
+  }
+}
@@ -0,0 +1,27 @@
+terraform {
+  required_version = ">= 1.8.0"
+  required_providers {
+    pinecone = {
+      source = "pinecone-io/pinecone"
+    }
+  }
+}
+
+provider "pinecone" {}
+
+variable "branch" {
+  type    = string
+  default = "Feature/Add_Search"
+}
+
+resource "pinecone_index" "preview" {
+  # "search-feature-add-search"
+  name      = provider::pinecone::sanitize_index_name("search-${var.branch}")
+  dimension = 1536
+  spec = {
+    serverless = {
+      cloud  = "aws"
+      region = "us-west-2"
+    }
+  }
+}
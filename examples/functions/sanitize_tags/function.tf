@@ -0,0 +1,27 @@
+terraform {
+  required_version = ">= 1.8.0"
+  required_providers {
+    pinecone = {
+      source = "pinecone-io/pinecone"
+    }
+  }
+}
+
+provider "pinecone" {}
+
+resource "pinecone_index" "example" {
+  name      = "example-index"
+  dimension = 1536
+  spec = {
+    serverless = {
+      cloud  = "aws"
+      region = "us-west-2"
+    }
+  }
+
+  # { git_branch = "feature_search_1", workspace = "staging" }
+  tags = provider::pinecone::sanitize_tags({
+    "git/branch" = "feature/search#1"
+    workspace    = terraform.workspace
+  })
+}
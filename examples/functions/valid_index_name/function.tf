@@ -0,0 +1,19 @@
+terraform {
+  required_version = ">= 1.8.0"
+  required_providers {
+    pinecone = {
+      source = "pinecone-io/pinecone"
+    }
+  }
+}
+
+provider "pinecone" {}
+
+variable "index_name" {
+  type = string
+
+  validation {
+    condition     = provider::pinecone::valid_index_name(var.index_name)
+    error_message = "The index name must be at most 45 lowercase alphanumeric characters or '-', starting and ending with an alphanumeric character."
+  }
+}
@@ -0,0 +1,9 @@
+# List the CI API keys of a project.
+list "pinecone_api_key" "ci" {
+  provider = pinecone
+
+  config {
+    project_id = "your-project-id"
+    name_regex = "^ci-"
+  }
+}
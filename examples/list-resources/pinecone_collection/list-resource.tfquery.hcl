@@ -0,0 +1,9 @@
+# List the ready collections of 1536-dimensional vectors.
+list "pinecone_collection" "ready" {
+  provider = pinecone
+
+  config {
+    status    = "Ready"
+    dimension = 1536
+  }
+}
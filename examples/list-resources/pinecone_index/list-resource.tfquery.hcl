@@ -0,0 +1,14 @@
+# List the production serverless indexes, with their full configuration, e.g. with
+# `terraform query -generate-config-out=indexes.tf`.
+list "pinecone_index" "production" {
+  provider         = pinecone
+  include_resource = true
+
+  config {
+    name_prefix = "prod-"
+    spec_type   = "serverless"
+    tags = {
+      environment = "production"
+    }
+  }
+}
@@ -0,0 +1,9 @@
+# List at most 20 of the projects created since 2025.
+list "pinecone_project" "recent" {
+  provider = pinecone
+  limit    = 20
+
+  config {
+    created_after = "2025-01-01T00:00:00Z"
+  }
+}
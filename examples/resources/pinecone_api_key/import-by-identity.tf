@@ -0,0 +1,7 @@
+import {
+  to = pinecone_api_key.example
+  identity = {
+    project_id = "your-project-id"
+    id         = "your-api-key-id"
+  }
+}
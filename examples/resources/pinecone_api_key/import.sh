@@ -0,0 +1,2 @@
+# API keys can be imported with the ID of their project and their own ID, separated by a colon.
+terraform import pinecone_api_key.example your-project-id:your-api-key-id
@@ -0,0 +1,7 @@
+import {
+  to = pinecone_collection.example
+  identity = {
+    project_id = "your-project-id"
+    name       = "example-collection"
+  }
+}
@@ -0,0 +1,2 @@
+# Collections can be imported by name.
+terraform import pinecone_collection.example example-collection
@@ -0,0 +1,2 @@
+# Indexes can be imported by name.
+terraform import pinecone_index.example example-index
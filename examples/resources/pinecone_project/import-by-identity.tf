@@ -0,0 +1,6 @@
+import {
+  to = pinecone_project.example
+  identity = {
+    id = "your-project-id"
+  }
+}
@@ -0,0 +1,2 @@
+# Projects can be imported by ID.
+terraform import pinecone_project.example your-project-id
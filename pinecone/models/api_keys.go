@@ -12,3 +12,15 @@ type ApiKeyResourceModel struct {
 	Key       types.String `tfsdk:"key"`
 	Roles     types.Set    `tfsdk:"roles"`
 }
+
+// ApiKeyListModel describes the configuration of the API key list resource.
+type ApiKeyListModel struct {
+	ProjectId types.String `tfsdk:"project_id"`
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+// ApiKeyIdentityModel describes the identity of an API key resource.
+type ApiKeyIdentityModel struct {
+	ProjectId types.String `tfsdk:"project_id"`
+	Id        types.String `tfsdk:"id"`
+}
@@ -35,15 +35,17 @@ func NewCollectionModel(collection *pinecone.Collection) *CollectionModel {
 
 // CollectionResourceModel describes the resource data model.
 type CollectionResourceModel struct {
-	Name        types.String   `tfsdk:"name"`
-	Size        types.Int64    `tfsdk:"size"`
-	Status      types.String   `tfsdk:"status"`
-	Dimension   types.Int32    `tfsdk:"dimension"`
-	VectorCount types.Int32    `tfsdk:"vector_count"`
-	Environment types.String   `tfsdk:"environment"`
-	Id          types.String   `tfsdk:"id"`
-	Source      types.String   `tfsdk:"source"`
-	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+	Name                 types.String   `tfsdk:"name"`
+	Size                 types.Int64    `tfsdk:"size"`
+	Status               types.String   `tfsdk:"status"`
+	Dimension            types.Int32    `tfsdk:"dimension"`
+	VectorCount          types.Int32    `tfsdk:"vector_count"`
+	Environment          types.String   `tfsdk:"environment"`
+	Id                   types.String   `tfsdk:"id"`
+	Source               types.String   `tfsdk:"source"`
+	PreventDestroyIfUsed types.Bool     `tfsdk:"prevent_destroy_if_used"`
+	WaitForReady         types.Bool     `tfsdk:"wait_for_ready"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (model *CollectionResourceModel) Read(collection *pinecone.Collection) {
@@ -80,6 +82,22 @@ func (model *CollectionDataSourceModel) Read(collection *pinecone.Collection) {
 
 // CollectionsDataSourceModel describes the data source data model.
 type CollectionsDataSourceModel struct {
+	CollectionFilterModel
 	Collections []CollectionModel `tfsdk:"collections"`
 	Id          types.String      `tfsdk:"id"`
 }
+
+// CollectionFilterModel describes the collection filters shared by the collections data source
+// and the collection list resource.
+type CollectionFilterModel struct {
+	Status      types.String `tfsdk:"status"`
+	Environment types.String `tfsdk:"environment"`
+	Dimension   types.Int32  `tfsdk:"dimension"`
+	MinSize     types.Int64  `tfsdk:"min_size"`
+}
+
+// CollectionIdentityModel describes the identity of a collection resource.
+type CollectionIdentityModel struct {
+	ProjectId types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 )
 
+// IndexModel describes an index listed by the indexes data source. It carries the same
+// attributes as IndexDatasourceModel apart from the identifier.
 type IndexModel struct {
 	Name               types.String `tfsdk:"name"`
 	Dimension          types.Int32  `tfsdk:"dimension"`
@@ -23,78 +25,31 @@ type IndexModel struct {
 	VectorType         types.String `tfsdk:"vector_type"`
 	Tags               types.Map    `tfsdk:"tags"`
 	Host               types.String `tfsdk:"host"`
+	PrivateHost        types.String `tfsdk:"private_host"`
 	Spec               types.Object `tfsdk:"spec"`
 	Status             types.Object `tfsdk:"status"`
 	Embed              types.Object `tfsdk:"embed"`
 }
 
 func (model *IndexModel) Read(ctx context.Context, index *pinecone.Index) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	model.Name = types.StringValue(index.Name)
-	model.Metric = types.StringValue(string(index.Metric))
-	model.VectorType = types.StringValue(index.VectorType)
-	model.Host = types.StringValue(index.Host)
-
-	if index.Dimension != nil {
-		model.Dimension = types.Int32Value(*index.Dimension)
-	} else {
-		model.Dimension = types.Int32Null()
-	}
-
-	pod, diags := NewIndexPodSpecModel(ctx, index.Spec.Pod)
-	if diags.HasError() {
-		return diags
-	}
-	serverless, diags := NewIndexServerlessSpecModel(ctx, index.Spec.Serverless)
-	if diags.HasError() {
-		return diags
-	}
-	byoc, diags := NewIndexBYOCSpecModel(ctx, index.Spec.BYOC)
+	var data IndexDatasourceModel
+	diags := data.Read(ctx, index)
 	if diags.HasError() {
 		return diags
 	}
-	spec := IndexSpecModel{
-		Pod:        pod,
-		Serverless: serverless,
-		BYOC:       byoc,
-	}
 
-	embed, diags := NewIndexEmbedModel(ctx, index.Embed)
-	if diags.HasError() {
-		return diags
-	}
-	if embed != nil {
-		model.Embed, diags = types.ObjectValueFrom(ctx, IndexEmbedModel{}.AttrTypes(), embed)
-		if diags.HasError() {
-			return diags
-		}
-	} else {
-		model.Embed = types.ObjectNull(IndexEmbedModel{}.AttrTypes())
-	}
-
-	model.Spec, diags = types.ObjectValueFrom(ctx, IndexSpecModel{}.AttrTypes(), spec)
-	if diags.HasError() {
-		return diags
-	}
-
-	model.Status, diags = types.ObjectValueFrom(ctx, IndexStatusModel{}.AttrTypes(), IndexStatusModel{
-		Ready: types.BoolValue(index.Status.Ready),
-		State: types.StringValue(string(index.Status.State)),
-	})
-	if diags.HasError() {
-		return diags
-	}
-
-	if index.Tags != nil {
-		model.Tags, diags = types.MapValueFrom(ctx, types.StringType, index.Tags)
-		if diags.HasError() {
-			return diags
-		}
-	} else {
-		// API returned no tags - set to empty map with explicit type
-		// This handles the case where config has tags = {} and API returns nothing
-		model.Tags = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	*model = IndexModel{
+		Name:               data.Name,
+		Dimension:          data.Dimension,
+		Metric:             data.Metric,
+		DeletionProtection: data.DeletionProtection,
+		VectorType:         data.VectorType,
+		Tags:               data.Tags,
+		Host:               data.Host,
+		PrivateHost:        data.PrivateHost,
+		Spec:               data.Spec,
+		Status:             data.Status,
+		Embed:              data.Embed,
 	}
 
 	return diags
@@ -110,6 +65,7 @@ type IndexResourceModel struct {
 	VectorType         types.String   `tfsdk:"vector_type"`
 	Tags               types.Map      `tfsdk:"tags"`
 	Host               types.String   `tfsdk:"host"`
+	PrivateHost        types.String   `tfsdk:"private_host"`
 	Spec               types.Object   `tfsdk:"spec"`
 	Status             types.Object   `tfsdk:"status"`
 	Embed              types.Object   `tfsdk:"embed"`
@@ -131,6 +87,7 @@ func (model *IndexResourceModel) Read(ctx context.Context, index *pinecone.Index
 	model.Name = types.StringValue(index.Name)
 	model.Metric = types.StringValue(string(index.Metric))
 	model.Host = types.StringValue(index.Host)
+	model.PrivateHost = types.StringPointerValue(index.PrivateHost)
 	model.DeletionProtection = types.StringValue(string(index.DeletionProtection))
 	model.VectorType = types.StringValue(index.VectorType)
 
@@ -212,6 +169,7 @@ type IndexDatasourceModel struct {
 	VectorType         types.String `tfsdk:"vector_type"`
 	Tags               types.Map    `tfsdk:"tags"`
 	Host               types.String `tfsdk:"host"`
+	PrivateHost        types.String `tfsdk:"private_host"`
 	Spec               types.Object `tfsdk:"spec"`
 	Status             types.Object `tfsdk:"status"`
 	Embed              types.Object `tfsdk:"embed"`
@@ -224,6 +182,7 @@ func (model *IndexDatasourceModel) Read(ctx context.Context, index *pinecone.Ind
 	model.Name = types.StringValue(index.Name)
 	model.Metric = types.StringValue(string(index.Metric))
 	model.Host = types.StringValue(index.Host)
+	model.PrivateHost = types.StringPointerValue(index.PrivateHost)
 	model.DeletionProtection = types.StringValue(string(index.DeletionProtection))
 	model.VectorType = types.StringValue(index.VectorType)
 
@@ -329,14 +288,11 @@ func NewIndexPodSpec(ctx context.Context, spec *IndexPodSpecModel) (*pinecone.Po
 			ShardCount:  int32(spec.ShardCount.ValueInt64()),
 		}
 
-		var metadataConfig pinecone.PodSpecMetadataConfig
-		if !spec.MetadataConfig.IsUnknown() {
-			diags := spec.MetadataConfig.As(ctx, &metadataConfig, basetypes.ObjectAsOptions{})
-			if diags.HasError() {
-				return nil, diags
-			}
-			newSpec.MetadataConfig = &metadataConfig
+		metadataConfig, diags := ToPodSpecMetadataConfig(ctx, spec.MetadataConfig)
+		if diags.HasError() {
+			return nil, diags
 		}
+		newSpec.MetadataConfig = metadataConfig
 		return newSpec, nil
 	}
 	return nil, nil
@@ -568,6 +524,28 @@ func (metadataConfig IndexMetadataConfigModel) AttrTypes() map[string]attr.Type
 	}
 }
 
+// ToPodSpecMetadataConfig converts a metadata_config object to *pinecone.PodSpecMetadataConfig.
+// Returns nil when the object or its indexed list is null or unknown, which indexes every field.
+func ToPodSpecMetadataConfig(ctx context.Context, obj types.Object) (*pinecone.PodSpecMetadataConfig, diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, nil
+	}
+
+	var model IndexMetadataConfigModel
+	if diags := obj.As(ctx, &model, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil, diags
+	}
+	if model.Indexed.IsNull() || model.Indexed.IsUnknown() {
+		return nil, nil
+	}
+
+	var indexed []string
+	if diags := model.Indexed.ElementsAs(ctx, &indexed, false); diags.HasError() {
+		return nil, diags
+	}
+	return &pinecone.PodSpecMetadataConfig{Indexed: &indexed}, nil
+}
+
 // ── MetadataSchema ────────────────────────────────────────────────────────────
 
 type IndexMetadataSchemaFieldModel struct {
@@ -1141,10 +1119,31 @@ func (status IndexStatusModel) AttrTypes() map[string]attr.Type {
 }
 
 type IndexesDataSourceModel struct {
+	IndexFilterModel
 	Indexes []IndexModel `tfsdk:"indexes"`
+	Names   types.List   `tfsdk:"names"`
 	Id      types.String `tfsdk:"id"`
 }
 
+// IndexFilterModel describes the index filters shared by the indexes data source and the
+// index list resource.
+type IndexFilterModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+	Tags       types.Map    `tfsdk:"tags"`
+	SpecType   types.String `tfsdk:"spec_type"`
+	Cloud      types.String `tfsdk:"cloud"`
+	Region     types.String `tfsdk:"region"`
+	VectorType types.String `tfsdk:"vector_type"`
+	ReadyOnly  types.Bool   `tfsdk:"ready_only"`
+}
+
+// IndexIdentityModel describes the identity of an index resource.
+type IndexIdentityModel struct {
+	ProjectId types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+}
+
 func mapAttrToInterfacePtr(attr types.Map) *map[string]interface{} {
 	if attr.IsUnknown() || attr.IsNull() {
 		return nil
@@ -0,0 +1,721 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+func TestMetadataSchema_roundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema *pinecone.MetadataSchema
+	}{
+		{
+			name:   "nil",
+			schema: nil,
+		},
+		{
+			name:   "no fields",
+			schema: &pinecone.MetadataSchema{Fields: map[string]pinecone.MetadataSchemaField{}},
+		},
+		{
+			name: "fields",
+			schema: &pinecone.MetadataSchema{Fields: map[string]pinecone.MetadataSchemaField{
+				"genre": {Filterable: true},
+				"year":  {Filterable: false},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			model, diags := NewIndexMetadataSchemaModel(ctx, tt.schema)
+			if diags.HasError() {
+				t.Fatalf("NewIndexMetadataSchemaModel: %v", diags)
+			}
+
+			obj := types.ObjectNull(IndexMetadataSchemaModel{}.AttrTypes())
+			if model != nil {
+				obj, diags = types.ObjectValueFrom(ctx, IndexMetadataSchemaModel{}.AttrTypes(), model)
+				if diags.HasError() {
+					t.Fatalf("ObjectValueFrom: %v", diags)
+				}
+			}
+
+			got, diags := ToMetadataSchema(ctx, obj)
+			if diags.HasError() {
+				t.Fatalf("ToMetadataSchema: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.schema) {
+				t.Errorf("round trip = %+v, want %+v", got, tt.schema)
+			}
+		})
+	}
+}
+
+func TestToMetadataSchema(t *testing.T) {
+	fieldsType := IndexMetadataSchemaModel{}.AttrTypes()["fields"].(types.MapType)
+
+	tests := []struct {
+		name string
+		obj  types.Object
+		want *pinecone.MetadataSchema
+	}{
+		{
+			name: "null",
+			obj:  types.ObjectNull(IndexMetadataSchemaModel{}.AttrTypes()),
+			want: nil,
+		},
+		{
+			name: "unknown",
+			obj:  types.ObjectUnknown(IndexMetadataSchemaModel{}.AttrTypes()),
+			want: nil,
+		},
+		{
+			name: "null fields",
+			obj: types.ObjectValueMust(IndexMetadataSchemaModel{}.AttrTypes(), map[string]attr.Value{
+				"fields": types.MapNull(fieldsType.ElemType),
+			}),
+			want: &pinecone.MetadataSchema{},
+		},
+		{
+			name: "unknown fields",
+			obj: types.ObjectValueMust(IndexMetadataSchemaModel{}.AttrTypes(), map[string]attr.Value{
+				"fields": types.MapUnknown(fieldsType.ElemType),
+			}),
+			want: &pinecone.MetadataSchema{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := ToMetadataSchema(t.Context(), tt.obj)
+			if diags.HasError() {
+				t.Fatalf("ToMetadataSchema: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToMetadataSchema() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadCapacity_roundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		rc   *pinecone.ReadCapacity
+		want *pinecone.ReadCapacityParams
+	}{
+		{
+			name: "nil",
+			rc:   nil,
+			want: nil,
+		},
+		{
+			name: "on demand",
+			rc: &pinecone.ReadCapacity{OnDemand: &pinecone.ReadCapacityOnDemand{
+				Status: pinecone.ReadCapacityStatus{State: "Ready"},
+			}},
+			want: &pinecone.ReadCapacityParams{OnDemand: &pinecone.ReadCapacityOnDemandConfig{}},
+		},
+		{
+			name: "dedicated with manual scaling",
+			rc: &pinecone.ReadCapacity{Dedicated: &pinecone.ReadCapacityDedicated{
+				NodeType: ptr("t1"),
+				Scaling: &pinecone.ReadCapacityScaling{Manual: &pinecone.ReadCapacityManualScaling{
+					Replicas: ptr(int32(2)),
+					Shards:   ptr(int32(1)),
+				}},
+				Status: pinecone.ReadCapacityStatus{State: "Scaling", CurrentReplicas: ptr(int32(1))},
+			}},
+			want: &pinecone.ReadCapacityParams{Dedicated: &pinecone.ReadCapacityDedicatedConfig{
+				NodeType: ptr("t1"),
+				Scaling: &pinecone.ReadCapacityScaling{Manual: &pinecone.ReadCapacityManualScaling{
+					Replicas: ptr(int32(2)),
+					Shards:   ptr(int32(1)),
+				}},
+			}},
+		},
+		{
+			name: "dedicated without scaling",
+			rc: &pinecone.ReadCapacity{Dedicated: &pinecone.ReadCapacityDedicated{
+				NodeType: ptr("b1"),
+			}},
+			want: &pinecone.ReadCapacityParams{Dedicated: &pinecone.ReadCapacityDedicatedConfig{
+				NodeType: ptr("b1"),
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			model, diags := NewIndexReadCapacityResourceModel(ctx, tt.rc)
+			if diags.HasError() {
+				t.Fatalf("NewIndexReadCapacityResourceModel: %v", diags)
+			}
+
+			obj := types.ObjectNull(IndexReadCapacityResourceModel{}.AttrTypes())
+			if model != nil {
+				obj, diags = types.ObjectValueFrom(ctx, IndexReadCapacityResourceModel{}.AttrTypes(), model)
+				if diags.HasError() {
+					t.Fatalf("ObjectValueFrom: %v", diags)
+				}
+			}
+
+			got, diags := ToReadCapacityParams(ctx, obj)
+			if diags.HasError() {
+				t.Fatalf("ToReadCapacityParams: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("round trip = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToReadCapacityParams(t *testing.T) {
+	attrTypes := IndexReadCapacityResourceModel{}.AttrTypes()
+	dedicatedTypes := IndexReadCapacityDedicatedResourceModel{}.AttrTypes()
+	onDemandTypes := IndexReadCapacityOnDemandResourceModel{}.AttrTypes()
+
+	tests := []struct {
+		name string
+		obj  types.Object
+		want *pinecone.ReadCapacityParams
+	}{
+		{
+			name: "null",
+			obj:  types.ObjectNull(attrTypes),
+			want: nil,
+		},
+		{
+			name: "unknown",
+			obj:  types.ObjectUnknown(attrTypes),
+			want: nil,
+		},
+		{
+			name: "no sub-block",
+			obj: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"dedicated": types.ObjectNull(dedicatedTypes),
+				"on_demand": types.ObjectNull(onDemandTypes),
+			}),
+			want: nil,
+		},
+		{
+			name: "unknown dedicated",
+			obj: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"dedicated": types.ObjectUnknown(dedicatedTypes),
+				"on_demand": types.ObjectNull(onDemandTypes),
+			}),
+			want: nil,
+		},
+		{
+			name: "dedicated with only replicas",
+			obj: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"dedicated": types.ObjectValueMust(dedicatedTypes, map[string]attr.Value{
+					"node_type": types.StringValue("t1"),
+					"replicas":  types.Int32Value(3),
+					"shards":    types.Int32Null(),
+				}),
+				"on_demand": types.ObjectNull(onDemandTypes),
+			}),
+			want: &pinecone.ReadCapacityParams{Dedicated: &pinecone.ReadCapacityDedicatedConfig{
+				NodeType: ptr("t1"),
+				Scaling: &pinecone.ReadCapacityScaling{Manual: &pinecone.ReadCapacityManualScaling{
+					Replicas: ptr(int32(3)),
+				}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := ToReadCapacityParams(t.Context(), tt.obj)
+			if diags.HasError() {
+				t.Fatalf("ToReadCapacityParams: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToReadCapacityParams() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexEmbed_roundTrip(t *testing.T) {
+	metric := pinecone.Cosine
+
+	tests := []struct {
+		name  string
+		embed *pinecone.IndexEmbed
+		want  *pinecone.IndexEmbed
+	}{
+		{
+			name:  "model only",
+			embed: &pinecone.IndexEmbed{Model: "multilingual-e5-large"},
+			want:  &pinecone.IndexEmbed{Model: "multilingual-e5-large"},
+		},
+		{
+			name: "all fields",
+			embed: &pinecone.IndexEmbed{
+				Model:           "multilingual-e5-large",
+				Dimension:       ptr(int32(1024)),
+				Metric:          &metric,
+				VectorType:      ptr("dense"),
+				FieldMap:        &map[string]interface{}{"text": "chunk_text"},
+				ReadParameters:  &map[string]interface{}{"input_type": "query"},
+				WriteParameters: &map[string]interface{}{"input_type": "passage", "truncate": "END"},
+			},
+			want: &pinecone.IndexEmbed{
+				Model:           "multilingual-e5-large",
+				Dimension:       ptr(int32(1024)),
+				Metric:          &metric,
+				VectorType:      ptr("dense"),
+				FieldMap:        &map[string]interface{}{"text": "chunk_text"},
+				ReadParameters:  &map[string]interface{}{"input_type": "query"},
+				WriteParameters: &map[string]interface{}{"input_type": "passage", "truncate": "END"},
+			},
+		},
+		{
+			// Parameters are strings in Terraform, so non-string values come back rendered.
+			name: "non-string parameters",
+			embed: &pinecone.IndexEmbed{
+				Model:           "pinecone-sparse-english-v0",
+				WriteParameters: &map[string]interface{}{"max_tokens_per_sequence": float64(512), "return_tokens": true},
+			},
+			want: &pinecone.IndexEmbed{
+				Model:           "pinecone-sparse-english-v0",
+				WriteParameters: &map[string]interface{}{"max_tokens_per_sequence": "512", "return_tokens": "true"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			model, diags := NewIndexEmbedModel(ctx, tt.embed)
+			if diags.HasError() {
+				t.Fatalf("NewIndexEmbedModel: %v", diags)
+			}
+
+			// The model must convert to an object of the schema type.
+			if _, diags := types.ObjectValueFrom(ctx, IndexEmbedModel{}.AttrTypes(), model); diags.HasError() {
+				t.Fatalf("ObjectValueFrom: %v", diags)
+			}
+
+			got, diags := NewIndexEmbed(ctx, model)
+			if diags.HasError() {
+				t.Fatalf("NewIndexEmbed: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("round trip = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewIndexEmbedModel_nil(t *testing.T) {
+	model, diags := NewIndexEmbedModel(t.Context(), nil)
+	if diags.HasError() {
+		t.Fatalf("NewIndexEmbedModel: %v", diags)
+	}
+
+	for name, v := range map[string]attr.Value{
+		"model":            model.Model,
+		"dimension":        model.Dimension,
+		"metric":           model.Metric,
+		"vector_type":      model.VectorType,
+		"field_map":        model.FieldMap,
+		"read_parameters":  model.ReadParameters,
+		"write_parameters": model.WriteParameters,
+	} {
+		if !v.IsNull() {
+			t.Errorf("%s = %s, want null", name, v)
+		}
+	}
+
+	embed, diags := NewIndexEmbed(t.Context(), nil)
+	if diags.HasError() || embed != nil {
+		t.Errorf("NewIndexEmbed(nil) = %+v, %v, want nil", embed, diags)
+	}
+}
+
+func TestNewIndexEmbedResourceModel(t *testing.T) {
+	ctx := t.Context()
+
+	model, diags := NewIndexEmbedResourceModel(ctx, nil)
+	if diags.HasError() || model != nil {
+		t.Fatalf("NewIndexEmbedResourceModel(nil) = %+v, %v, want nil", model, diags)
+	}
+
+	model, diags = NewIndexEmbedResourceModel(ctx, &pinecone.IndexEmbed{
+		Model:          "multilingual-e5-large",
+		FieldMap:       &map[string]interface{}{"text": "chunk_text"},
+		ReadParameters: &map[string]interface{}{"input_type": "query", "truncate": "END"},
+	})
+	if diags.HasError() {
+		t.Fatalf("NewIndexEmbedResourceModel: %v", diags)
+	}
+
+	want := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"input_type": types.StringValue("query"),
+		"truncate":   types.StringValue("END"),
+	})
+	if !model.EffectiveReadParameters.Equal(want) {
+		t.Errorf("effective_read_parameters = %s, want %s", model.EffectiveReadParameters, want)
+	}
+	if !model.EffectiveWriteParameters.IsNull() {
+		t.Errorf("effective_write_parameters = %s, want null", model.EffectiveWriteParameters)
+	}
+	if _, diags := types.ObjectValueFrom(ctx, IndexEmbedResourceModel{}.AttrTypes(), model); diags.HasError() {
+		t.Errorf("ObjectValueFrom: %v", diags)
+	}
+}
+
+func TestPodSpec_roundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		spec *pinecone.PodSpec
+		want *pinecone.PodSpec
+	}{
+		{
+			name: "nil",
+			spec: nil,
+			want: nil,
+		},
+		{
+			name: "metadata config",
+			spec: &pinecone.PodSpec{
+				Environment:      "us-west4-gcp",
+				PodType:          "p1.x1",
+				PodCount:         4,
+				Replicas:         2,
+				ShardCount:       2,
+				SourceCollection: ptr("movies"),
+				MetadataConfig:   &pinecone.PodSpecMetadataConfig{Indexed: &[]string{"genre"}},
+			},
+			want: &pinecone.PodSpec{
+				Environment:    "us-west4-gcp",
+				PodType:        "p1.x1",
+				PodCount:       4,
+				Replicas:       2,
+				ShardCount:     2,
+				MetadataConfig: &pinecone.PodSpecMetadataConfig{Indexed: &[]string{"genre"}},
+			},
+		},
+		{
+			name: "no metadata config",
+			spec: &pinecone.PodSpec{
+				Environment: "us-west4-gcp",
+				PodType:     "s1.x1",
+				PodCount:    1,
+				Replicas:    1,
+				ShardCount:  1,
+			},
+			want: &pinecone.PodSpec{
+				Environment: "us-west4-gcp",
+				PodType:     "s1.x1",
+				PodCount:    1,
+				Replicas:    1,
+				ShardCount:  1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			model, diags := NewIndexPodSpecModel(ctx, tt.spec)
+			if diags.HasError() {
+				t.Fatalf("NewIndexPodSpecModel: %v", diags)
+			}
+
+			got, diags := NewIndexPodSpec(ctx, model)
+			if diags.HasError() {
+				t.Fatalf("NewIndexPodSpec: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("round trip = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToPodSpecMetadataConfig(t *testing.T) {
+	attrTypes := IndexMetadataConfigModel{}.AttrTypes()
+
+	tests := []struct {
+		name string
+		obj  types.Object
+		want *pinecone.PodSpecMetadataConfig
+	}{
+		{
+			name: "null",
+			obj:  types.ObjectNull(attrTypes),
+		},
+		{
+			name: "unknown",
+			obj:  types.ObjectUnknown(attrTypes),
+		},
+		{
+			name: "null indexed",
+			obj:  types.ObjectValueMust(attrTypes, map[string]attr.Value{"indexed": types.ListNull(types.StringType)}),
+		},
+		{
+			name: "unknown indexed",
+			obj:  types.ObjectValueMust(attrTypes, map[string]attr.Value{"indexed": types.ListUnknown(types.StringType)}),
+		},
+		{
+			name: "empty indexed",
+			obj: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"indexed": types.ListValueMust(types.StringType, []attr.Value{}),
+			}),
+			want: &pinecone.PodSpecMetadataConfig{Indexed: &[]string{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := ToPodSpecMetadataConfig(t.Context(), tt.obj)
+			if diags.HasError() {
+				t.Fatalf("ToPodSpecMetadataConfig: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToPodSpecMetadataConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexResourceModel_Read(t *testing.T) {
+	ctx := t.Context()
+
+	tests := []struct {
+		name  string
+		index *pinecone.Index
+		check func(t *testing.T, model IndexResourceModel)
+	}{
+		{
+			name: "serverless without optional fields",
+			index: &pinecone.Index{
+				Name:               "example",
+				Host:               "example.svc.pinecone.io",
+				Metric:             pinecone.Cosine,
+				VectorType:         "sparse",
+				DeletionProtection: pinecone.DeletionProtectionDisabled,
+				Spec: &pinecone.IndexSpec{Serverless: &pinecone.ServerlessSpec{
+					Cloud:  pinecone.Aws,
+					Region: "us-east-1",
+				}},
+			},
+			check: func(t *testing.T, model IndexResourceModel) {
+				if !model.Dimension.IsNull() {
+					t.Errorf("dimension = %s, want null", model.Dimension)
+				}
+				if !model.PrivateHost.IsNull() {
+					t.Errorf("private_host = %s, want null", model.PrivateHost)
+				}
+				if !model.Status.IsNull() {
+					t.Errorf("status = %s, want null", model.Status)
+				}
+				if !model.Embed.IsNull() {
+					t.Errorf("embed = %s, want null", model.Embed)
+				}
+				if model.Tags.IsNull() || len(model.Tags.Elements()) != 0 {
+					t.Errorf("tags = %s, want empty map", model.Tags)
+				}
+				serverless := model.Spec.Attributes()["serverless"].(types.Object)
+				if !serverless.Attributes()["read_capacity"].IsNull() || !serverless.Attributes()["schema"].IsNull() {
+					t.Errorf("spec.serverless = %s, want null read_capacity and schema", serverless)
+				}
+				if !model.Spec.Attributes()["pod"].IsNull() || !model.Spec.Attributes()["byoc"].IsNull() {
+					t.Errorf("spec = %s, want null pod and byoc", model.Spec)
+				}
+			},
+		},
+		{
+			name: "pod with tags, status and embed",
+			index: &pinecone.Index{
+				Name:               "example",
+				Host:               "example.svc.pinecone.io",
+				PrivateHost:        ptr("example.svc.private.pinecone.io"),
+				Metric:             pinecone.Dotproduct,
+				VectorType:         "dense",
+				Dimension:          ptr(int32(1536)),
+				DeletionProtection: pinecone.DeletionProtectionEnabled,
+				Spec: &pinecone.IndexSpec{Pod: &pinecone.PodSpec{
+					Environment: "us-west4-gcp",
+					PodType:     "s1.x1",
+					PodCount:    1,
+					Replicas:    1,
+					ShardCount:  1,
+				}},
+				Status: &pinecone.IndexStatus{Ready: true, State: pinecone.Ready},
+				Tags:   &pinecone.IndexTags{"env": "prod"},
+				Embed:  &pinecone.IndexEmbed{Model: "multilingual-e5-large"},
+			},
+			check: func(t *testing.T, model IndexResourceModel) {
+				if model.Dimension.ValueInt32() != 1536 {
+					t.Errorf("dimension = %s, want 1536", model.Dimension)
+				}
+				if model.PrivateHost.ValueString() != "example.svc.private.pinecone.io" {
+					t.Errorf("private_host = %s", model.PrivateHost)
+				}
+				if model.DeletionProtection.ValueString() != "enabled" {
+					t.Errorf("deletion_protection = %s, want enabled", model.DeletionProtection)
+				}
+				wantTags := types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")})
+				if !model.Tags.Equal(wantTags) {
+					t.Errorf("tags = %s, want %s", model.Tags, wantTags)
+				}
+				wantStatus := types.ObjectValueMust(IndexStatusModel{}.AttrTypes(), map[string]attr.Value{
+					"ready": types.BoolValue(true),
+					"state": types.StringValue("Ready"),
+				})
+				if !model.Status.Equal(wantStatus) {
+					t.Errorf("status = %s, want %s", model.Status, wantStatus)
+				}
+				if model.Embed.Attributes()["model"].(types.String).ValueString() != "multilingual-e5-large" {
+					t.Errorf("embed = %s", model.Embed)
+				}
+				if !model.Spec.Attributes()["serverless"].IsNull() {
+					t.Errorf("spec.serverless = %s, want null", model.Spec.Attributes()["serverless"])
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var model IndexResourceModel
+			if diags := model.Read(ctx, tt.index); diags.HasError() {
+				t.Fatalf("Read: %v", diags)
+			}
+			if model.Id.ValueString() != tt.index.Name || model.Name.ValueString() != tt.index.Name {
+				t.Errorf("id = %s, name = %s, want %s", model.Id, model.Name, tt.index.Name)
+			}
+			tt.check(t, model)
+		})
+	}
+
+	t.Run("nil index", func(t *testing.T) {
+		var model IndexResourceModel
+		if diags := model.Read(ctx, nil); !diags.HasError() {
+			t.Error("expected an error reading a nil index")
+		}
+	})
+}
+
+func TestMapAttrToInterfacePtr(t *testing.T) {
+	tests := []struct {
+		name string
+		in   types.Map
+		want *map[string]interface{}
+	}{
+		{
+			name: "null",
+			in:   types.MapNull(types.StringType),
+			want: nil,
+		},
+		{
+			name: "unknown",
+			in:   types.MapUnknown(types.StringType),
+			want: nil,
+		},
+		{
+			name: "empty",
+			in:   types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			want: &map[string]interface{}{},
+		},
+		{
+			name: "strings",
+			in: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"text": types.StringValue("chunk_text"),
+			}),
+			want: &map[string]interface{}{"text": "chunk_text"},
+		},
+		{
+			name: "non-string elements",
+			in: types.MapValueMust(types.Int64Type, map[string]attr.Value{
+				"max_tokens": types.Int64Value(512),
+			}),
+			want: &map[string]interface{}{"max_tokens": "512"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapAttrToInterfacePtr(tt.in)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mapAttrToInterfacePtr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToMapStringString(t *testing.T) {
+	var nilMap *map[string]interface{}
+
+	tests := []struct {
+		name   string
+		in     interface{}
+		want   map[string]string
+		wantOk bool
+	}{
+		{
+			name: "nil",
+			in:   nil,
+		},
+		{
+			name: "nil map pointer",
+			in:   nilMap,
+		},
+		{
+			name: "unsupported type",
+			in:   []string{"text"},
+		},
+		{
+			name:   "map of strings",
+			in:     map[string]string{"text": "chunk_text"},
+			want:   map[string]string{"text": "chunk_text"},
+			wantOk: true,
+		},
+		{
+			name:   "map of values",
+			in:     map[string]interface{}{"text": "chunk_text", "size": float64(512), "sparse": false},
+			want:   map[string]string{"text": "chunk_text", "size": "512", "sparse": "false"},
+			wantOk: true,
+		},
+		{
+			name:   "map pointer",
+			in:     &map[string]interface{}{"text": "chunk_text"},
+			want:   map[string]string{"text": "chunk_text"},
+			wantOk: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := toMapStringString(tt.in)
+			if ok != tt.wantOk || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toMapStringString() = %v, %t, want %v, %t", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
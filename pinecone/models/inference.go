@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+// InferenceModelsDataSourceModel describes the inference models data source data model.
+type InferenceModelsDataSourceModel struct {
+	Type       types.String          `tfsdk:"type"`
+	VectorType types.String          `tfsdk:"vector_type"`
+	Models     []InferenceModelModel `tfsdk:"models"`
+	Id         types.String          `tfsdk:"id"`
+}
+
+// InferenceModelModel describes a single hosted inference model.
+type InferenceModelModel struct {
+	Name                types.String `tfsdk:"name"`
+	Type                types.String `tfsdk:"type"`
+	VectorType          types.String `tfsdk:"vector_type"`
+	ShortDescription    types.String `tfsdk:"short_description"`
+	ProviderName        types.String `tfsdk:"provider_name"`
+	Modality            types.String `tfsdk:"modality"`
+	DefaultDimension    types.Int32  `tfsdk:"default_dimension"`
+	SupportedDimensions types.List   `tfsdk:"supported_dimensions"`
+	SupportedMetrics    types.List   `tfsdk:"supported_metrics"`
+	MaxSequenceLength   types.Int32  `tfsdk:"max_sequence_length"`
+	MaxBatchSize        types.Int32  `tfsdk:"max_batch_size"`
+	SupportedParameters types.List   `tfsdk:"supported_parameters"`
+}
+
+// InferenceModelParameterModel describes a parameter supported by an inference model.
+type InferenceModelParameterModel struct {
+	Parameter     types.String  `tfsdk:"parameter"`
+	Type          types.String  `tfsdk:"type"`
+	ValueType     types.String  `tfsdk:"value_type"`
+	Required      types.Bool    `tfsdk:"required"`
+	AllowedValues types.List    `tfsdk:"allowed_values"`
+	Default       types.String  `tfsdk:"default"`
+	Min           types.Float64 `tfsdk:"min"`
+	Max           types.Float64 `tfsdk:"max"`
+}
+
+func (model InferenceModelParameterModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"parameter":      types.StringType,
+		"type":           types.StringType,
+		"value_type":     types.StringType,
+		"required":       types.BoolType,
+		"allowed_values": types.ListType{ElemType: types.StringType},
+		"default":        types.StringType,
+		"min":            types.Float64Type,
+		"max":            types.Float64Type,
+	}
+}
+
+// NewInferenceModelModel creates a new InferenceModelModel from a pinecone.ModelInfo.
+func NewInferenceModelModel(ctx context.Context, model *pinecone.ModelInfo) (*InferenceModelModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newModel := &InferenceModelModel{
+		Name:              types.StringValue(model.Model),
+		Type:              types.StringValue(model.Type),
+		VectorType:        types.StringPointerValue(model.VectorType),
+		ShortDescription:  types.StringValue(model.ShortDescription),
+		ProviderName:      types.StringPointerValue(model.ProviderName),
+		Modality:          types.StringPointerValue(model.Modality),
+		DefaultDimension:  types.Int32PointerValue(model.DefaultDimension),
+		MaxSequenceLength: types.Int32PointerValue(model.MaxSequenceLength),
+		MaxBatchSize:      types.Int32PointerValue(model.MaxBatchSize),
+	}
+
+	if model.SupportedDimensions != nil {
+		newModel.SupportedDimensions, diags = types.ListValueFrom(ctx, types.Int32Type, *model.SupportedDimensions)
+		if diags.HasError() {
+			return nil, diags
+		}
+	} else {
+		newModel.SupportedDimensions = types.ListNull(types.Int32Type)
+	}
+
+	if model.SupportedMetrics != nil {
+		metrics := make([]string, 0, len(*model.SupportedMetrics))
+		for _, m := range *model.SupportedMetrics {
+			metrics = append(metrics, string(m))
+		}
+		newModel.SupportedMetrics, diags = types.ListValueFrom(ctx, types.StringType, metrics)
+		if diags.HasError() {
+			return nil, diags
+		}
+	} else {
+		newModel.SupportedMetrics = types.ListNull(types.StringType)
+	}
+
+	parameterType := types.ObjectType{AttrTypes: InferenceModelParameterModel{}.AttrTypes()}
+	if model.SupportedParameters != nil {
+		parameters := make([]InferenceModelParameterModel, 0, len(*model.SupportedParameters))
+		for _, p := range *model.SupportedParameters {
+			parameter := InferenceModelParameterModel{
+				Parameter: types.StringValue(p.Parameter),
+				Type:      types.StringValue(p.Type),
+				ValueType: types.StringValue(p.ValueType),
+				Required:  types.BoolValue(p.Required),
+				Default:   types.StringNull(),
+				Min:       types.Float64Null(),
+				Max:       types.Float64Null(),
+			}
+			if p.Default != nil {
+				parameter.Default = SupportedParameterValueString(*p.Default)
+			}
+			if p.Min != nil {
+				parameter.Min = types.Float64Value(float64(*p.Min))
+			}
+			if p.Max != nil {
+				parameter.Max = types.Float64Value(float64(*p.Max))
+			}
+			if p.AllowedValues != nil {
+				allowed := make([]types.String, 0, len(*p.AllowedValues))
+				for _, v := range *p.AllowedValues {
+					allowed = append(allowed, SupportedParameterValueString(v))
+				}
+				parameter.AllowedValues, diags = types.ListValueFrom(ctx, types.StringType, allowed)
+				if diags.HasError() {
+					return nil, diags
+				}
+			} else {
+				parameter.AllowedValues = types.ListNull(types.StringType)
+			}
+			parameters = append(parameters, parameter)
+		}
+		newModel.SupportedParameters, diags = types.ListValueFrom(ctx, parameterType, parameters)
+		if diags.HasError() {
+			return nil, diags
+		}
+	} else {
+		newModel.SupportedParameters = types.ListNull(parameterType)
+	}
+
+	return newModel, diags
+}
+
+// SupportedParameterValueString renders a model parameter value, which may be a
+// string, integer, float or boolean, as a string.
+func SupportedParameterValueString(value pinecone.SupportedParameterValue) types.String {
+	switch {
+	case value.StringValue != nil:
+		return types.StringValue(*value.StringValue)
+	case value.IntValue != nil:
+		return types.StringValue(strconv.FormatInt(int64(*value.IntValue), 10))
+	case value.FloatValue != nil:
+		return types.StringValue(strconv.FormatFloat(float64(*value.FloatValue), 'f', -1, 32))
+	case value.BoolValue != nil:
+		return types.StringValue(strconv.FormatBool(*value.BoolValue))
+	}
+	return types.StringNull()
+}
+
+// EmbeddingsDataSourceModel describes the embeddings data source data model.
+type EmbeddingsDataSourceModel struct {
+	Model      types.String     `tfsdk:"model"`
+	Inputs     types.List       `tfsdk:"inputs"`
+	InputType  types.String     `tfsdk:"input_type"`
+	Truncate   types.String     `tfsdk:"truncate"`
+	Dimension  types.Int32      `tfsdk:"dimension"`
+	VectorType types.String     `tfsdk:"vector_type"`
+	Embeddings []EmbeddingModel `tfsdk:"embeddings"`
+	Id         types.String     `tfsdk:"id"`
+}
+
+// EmbeddingModel describes the dense or sparse embedding of a single input.
+type EmbeddingModel struct {
+	Input         types.String `tfsdk:"input"`
+	Values        types.List   `tfsdk:"values"`
+	SparseValues  types.List   `tfsdk:"sparse_values"`
+	SparseIndices types.List   `tfsdk:"sparse_indices"`
+	SparseTokens  types.List   `tfsdk:"sparse_tokens"`
+}
+
+// NewEmbeddingModel creates a new EmbeddingModel from a pinecone.Embedding of the given input.
+func NewEmbeddingModel(ctx context.Context, input string, embedding pinecone.Embedding) (*EmbeddingModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newModel := &EmbeddingModel{
+		Input:         types.StringValue(input),
+		Values:        types.ListNull(types.Float64Type),
+		SparseValues:  types.ListNull(types.Float64Type),
+		SparseIndices: types.ListNull(types.Int64Type),
+		SparseTokens:  types.ListNull(types.StringType),
+	}
+
+	if embedding.DenseEmbedding != nil {
+		newModel.Values, diags = types.ListValueFrom(ctx, types.Float64Type, toFloat64s(embedding.DenseEmbedding.Values))
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	if embedding.SparseEmbedding != nil {
+		newModel.SparseValues, diags = types.ListValueFrom(ctx, types.Float64Type, toFloat64s(embedding.SparseEmbedding.SparseValues))
+		if diags.HasError() {
+			return nil, diags
+		}
+		newModel.SparseIndices, diags = types.ListValueFrom(ctx, types.Int64Type, embedding.SparseEmbedding.SparseIndices)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if embedding.SparseEmbedding.SparseTokens != nil {
+			newModel.SparseTokens, diags = types.ListValueFrom(ctx, types.StringType, *embedding.SparseEmbedding.SparseTokens)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+	}
+
+	return newModel, diags
+}
+
+func toFloat64s(values []float32) []float64 {
+	result := make([]float64, len(values))
+	for i, v := range values {
+		result[i] = float64(v)
+	}
+	return result
+}
+
+// RerankDataSourceModel describes the rerank data source data model.
+type RerankDataSourceModel struct {
+	Model      types.String          `tfsdk:"model"`
+	Query      types.String          `tfsdk:"query"`
+	Documents  types.List            `tfsdk:"documents"`
+	TopN       types.Int64           `tfsdk:"top_n"`
+	RankFields types.List            `tfsdk:"rank_fields"`
+	Results    []RankedDocumentModel `tfsdk:"results"`
+	Id         types.String          `tfsdk:"id"`
+}
+
+// RankedDocumentModel describes the relevance score of a single document.
+type RankedDocumentModel struct {
+	Index types.Int64   `tfsdk:"index"`
+	Score types.Float64 `tfsdk:"score"`
+}
@@ -3,18 +3,21 @@ package models
 import (
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 )
 
 // ProjectResourceModel defines the project model for the resource.
 type ProjectResourceModel struct {
-	Id                      types.String `tfsdk:"id"`
-	Name                    types.String `tfsdk:"name"`
-	OrganizationId          types.String `tfsdk:"organization_id"`
-	ForceEncryptionWithCmek types.Bool   `tfsdk:"force_encryption_with_cmek"`
-	MaxPods                 types.Int64  `tfsdk:"max_pods"`
-	CreatedAt               types.String `tfsdk:"created_at"`
+	Id                      types.String   `tfsdk:"id"`
+	Name                    types.String   `tfsdk:"name"`
+	OrganizationId          types.String   `tfsdk:"organization_id"`
+	ForceEncryptionWithCmek types.Bool     `tfsdk:"force_encryption_with_cmek"`
+	MaxPods                 types.Int64    `tfsdk:"max_pods"`
+	CreatedAt               types.String   `tfsdk:"created_at"`
+	ForceDestroy            types.Bool     `tfsdk:"force_destroy"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ProjectDataSourceModel defines the project model for the data source.
@@ -29,10 +32,25 @@ type ProjectDataSourceModel struct {
 
 // ProjectsDataSourceModel defines the projects list model for the data source.
 type ProjectsDataSourceModel struct {
+	ProjectFilterModel
 	Projects []ProjectModel `tfsdk:"projects"`
 	Id       types.String   `tfsdk:"id"`
 }
 
+// ProjectFilterModel describes the project filters shared by the projects data source and the
+// project list resource.
+type ProjectFilterModel struct {
+	NameRegex               types.String `tfsdk:"name_regex"`
+	CreatedAfter            types.String `tfsdk:"created_after"`
+	CreatedBefore           types.String `tfsdk:"created_before"`
+	ForceEncryptionWithCmek types.Bool   `tfsdk:"force_encryption_with_cmek"`
+}
+
+// ProjectIdentityModel describes the identity of a project resource.
+type ProjectIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
 // ProjectModel defines a single project in the projects list.
 type ProjectModel struct {
 	Id                      types.String `tfsdk:"id"`
@@ -43,6 +61,21 @@ type ProjectModel struct {
 	CreatedAt               types.String `tfsdk:"created_at"`
 }
 
+// Read populates the ProjectResourceModel from a pinecone.Project. ForceDestroy is a
+// provider-side setting and is left unchanged.
+func (m *ProjectResourceModel) Read(project *pinecone.Project) {
+	m.Id = types.StringValue(project.Id)
+	m.Name = types.StringValue(project.Name)
+	m.OrganizationId = types.StringValue(project.OrganizationId)
+	m.ForceEncryptionWithCmek = types.BoolValue(project.ForceEncryptionWithCmek)
+	m.MaxPods = types.Int64Value(int64(project.MaxPods))
+	if project.CreatedAt != nil {
+		m.CreatedAt = types.StringValue(project.CreatedAt.Format(time.RFC3339))
+	} else {
+		m.CreatedAt = types.StringNull()
+	}
+}
+
 // Read populates the ProjectDataSourceModel from a pinecone.Project.
 func (m *ProjectDataSourceModel) Read(project *pinecone.Project) {
 	m.Id = types.StringValue(project.Id)
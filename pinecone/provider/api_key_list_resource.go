@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &ApiKeyListResource{}
+var _ list.ListResourceWithConfigure = &ApiKeyListResource{}
+
+func NewApiKeyListResource() list.ListResource {
+	return &ApiKeyListResource{ApiKeyResource: &ApiKeyResource{PineconeResource: &PineconeResource{}}}
+}
+
+// ApiKeyListResource lists the API keys of a project, for `terraform query`.
+type ApiKeyListResource struct {
+	*ApiKeyResource
+}
+
+func (r *ApiKeyListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the API keys of a project, sorted by name. The secret values of the keys are not returned.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project whose API keys are listed.",
+				Required:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list API keys whose name matches this regular expression.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ApiKeyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data models.ApiKeyListModel
+	var diags diag.Diagnostics
+
+	// Read Terraform configuration data into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	// Check if admin client is available
+	if r.adminClient == nil {
+		diags.AddError("Admin client not configured", "Admin client credentials (client_id and client_secret) are required to list API keys.")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	apiKeys, err := r.adminClient.APIKey.List(ctx, data.ProjectId.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list API keys, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	sortApiKeys(apiKeys)
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, apiKey := range apiKeys {
+			if nameRegex != nil && !nameRegex.MatchString(apiKey.Name) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = apiKey.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, models.ApiKeyIdentityModel{
+				ProjectId: data.ProjectId,
+				Id:        types.StringValue(apiKey.Id),
+			})...)
+
+			if req.IncludeResource {
+				// The key value is only returned when the key is created.
+				roles, rolesDiags := types.SetValueFrom(ctx, types.StringType, apiKey.Roles)
+				result.Diagnostics.Append(rolesDiags...)
+				model := models.ApiKeyResourceModel{
+					Id:        types.StringValue(apiKey.Id),
+					Name:      types.StringValue(apiKey.Name),
+					ProjectId: data.ProjectId,
+					Key:       types.StringNull(),
+					Roles:     roles,
+				}
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// sortApiKeys sorts API keys by name, so the list does not change when the API reorders them.
+// API key names are not unique, so keys sharing a name are ordered by ID.
+func sortApiKeys(apiKeys []*pinecone.APIKey) {
+	sort.Slice(apiKeys, func(i, j int) bool {
+		if apiKeys[i].Name != apiKeys[j].Name {
+			return apiKeys[i].Name < apiKeys[j].Name
+		}
+		return apiKeys[i].Id < apiKeys[j].Id
+	})
+}
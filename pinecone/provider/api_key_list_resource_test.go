@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+func TestApiKeyListResource_mock(t *testing.T) {
+	server := newMockPineconeServer(t)
+	projectId := server.AddProject("prod")
+	otherProjectId := server.AddProject("staging")
+	ciId := server.AddApiKey(projectId, "ci")
+	server.AddApiKey(projectId, "laptop")
+	server.AddApiKey(otherProjectId, "ci")
+
+	results := server.List(t, "pinecone_api_key", map[string]tftypes.Value{
+		"project_id": tftypes.NewValue(tftypes.String, projectId),
+		"name_regex": tftypes.NewValue(tftypes.String, "^ci$"),
+	}, true, 0)
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	result := results[0]
+	for _, d := range result.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if result.DisplayName != "ci" {
+		t.Errorf("expected ci, got %s", result.DisplayName)
+	}
+	if !result.Identity["project_id"].Equal(tftypes.NewValue(tftypes.String, projectId)) ||
+		!result.Identity["id"].Equal(tftypes.NewValue(tftypes.String, ciId)) {
+		t.Errorf("unexpected identity %v", result.Identity)
+	}
+	if !result.Resource["key"].IsNull() {
+		t.Errorf("expected a null key, got %s", result.Resource["key"])
+	}
+}
+
+func TestSortApiKeys(t *testing.T) {
+	apiKeys := []*pinecone.APIKey{
+		{Id: "3", Name: "b"},
+		{Id: "2", Name: "a"},
+		{Id: "4", Name: "a"},
+		{Id: "1", Name: "a"},
+	}
+
+	sortApiKeys(apiKeys)
+
+	var got []string
+	for _, apiKey := range apiKeys {
+		got = append(got, apiKey.Name+apiKey.Id)
+	}
+	if want := "[a1 a2 a4 b3]"; fmt.Sprint(got) != want {
+		t.Fatalf("expected %s, got %v", want, got)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -21,6 +22,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ApiKeyResource{}
 var _ resource.ResourceWithImportState = &ApiKeyResource{}
+var _ resource.ResourceWithIdentity = &ApiKeyResource{}
 
 func NewApiKeyResource() resource.Resource {
 	return &ApiKeyResource{PineconeResource: &PineconeResource{}}
@@ -71,6 +73,21 @@ func (r *ApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *ApiKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"project_id": identityschema.StringAttribute{
+				Description:       "The ID of the project the API key belongs to.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the API key.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data models.ApiKeyResourceModel
 
@@ -124,6 +141,7 @@ func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.ApiKeyIdentityModel{ProjectId: data.ProjectId, Id: data.Id})...)
 }
 
 func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -136,6 +154,10 @@ func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	// The identity only depends on the prior state. It is set before describing the API key, as
+	// it is also required when the API key no longer exists.
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.ApiKeyIdentityModel{ProjectId: data.ProjectId, Id: data.Id})...)
+
 	// Check if admin client is available
 	if r.adminClient == nil {
 		resp.Diagnostics.AddError("Admin client not configured", "Admin client credentials (client_id and client_secret) are required to read API keys.")
@@ -214,6 +236,7 @@ func (r *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if updateParams.Name == nil && updateParams.Roles == nil {
 		// No changes, just save the current state
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, models.ApiKeyIdentityModel{ProjectId: state.ProjectId, Id: state.Id})...)
 		return
 	}
 
@@ -237,6 +260,7 @@ func (r *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.ApiKeyIdentityModel{ProjectId: state.ProjectId, Id: state.Id})...)
 }
 
 func (r *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -288,6 +312,23 @@ func (r *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *ApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by identity with an import block
+	if req.ID == "" {
+		var identity models.ApiKeyIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if identity.ProjectId.ValueString() == "" || identity.Id.ValueString() == "" {
+			resp.Diagnostics.AddError("Invalid import identity", "Both project_id and id are required to import an API key.")
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), identity.ProjectId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.Id)...)
+		return
+	}
+
 	// Import format: project_id:api_key_id
 	parts := strings.Split(req.ID, ":")
 	if len(parts) != 2 {
@@ -5,6 +5,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -153,3 +154,90 @@ resource "pinecone_api_key" "update_test" {
 }
 `, os.Getenv("PINECONE_CLIENT_ID"), os.Getenv("PINECONE_CLIENT_SECRET"), name, projectId, rolesConfig)
 }
+
+func TestApiKeyResource_mock(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+
+	config := func(name string, roles string) string {
+		return server.ProviderConfig() + fmt.Sprintf(`
+resource "pinecone_project" "test" {
+  name          = "mock-project"
+  force_destroy = true
+}
+
+resource "pinecone_api_key" "test" {
+  name       = %q
+  project_id = pinecone_project.test.id
+  %s
+}
+`, name, roles)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		CheckDestroy:             server.CheckDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("mock-key", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "name", "mock-key"),
+					resource.TestCheckResourceAttrPair("pinecone_api_key.test", "project_id", "pinecone_project.test", "id"),
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("pinecone_api_key.test", "roles.*", "ProjectEditor"),
+					resource.TestCheckResourceAttrSet("pinecone_api_key.test", "key"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: config("mock-key-updated", `roles = ["ProjectViewer", "DataPlaneViewer"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "name", "mock-key-updated"),
+					resource.TestCheckResourceAttr("pinecone_api_key.test", "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr("pinecone_api_key.test", "roles.*", "ProjectViewer"),
+					resource.TestCheckTypeSetElemAttr("pinecone_api_key.test", "roles.*", "DataPlaneViewer"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestApiKeyResource_mock_importByIdentity(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	projectId := server.AddProject("mock-project")
+	apiKeyId := server.AddApiKey(projectId, "mock-key")
+
+	state, identity, diags := server.ImportByIdentity(t, "pinecone_api_key", map[string]tftypes.Value{
+		"project_id": tftypes.NewValue(tftypes.String, projectId),
+		"id":         tftypes.NewValue(tftypes.String, apiKeyId),
+	})
+	for _, d := range diags {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if !state["name"].Equal(tftypes.NewValue(tftypes.String, "mock-key")) {
+		t.Errorf("name: got %s", state["name"])
+	}
+	if !state["project_id"].Equal(tftypes.NewValue(tftypes.String, projectId)) {
+		t.Errorf("project_id: got %s", state["project_id"])
+	}
+	if !identity["project_id"].Equal(tftypes.NewValue(tftypes.String, projectId)) || !identity["id"].Equal(tftypes.NewValue(tftypes.String, apiKeyId)) {
+		t.Errorf("identity: got %v", identity)
+	}
+}
+
+func TestApiKeyResource_mock_importByIdentityMissingProject(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+
+	_, _, diags := server.ImportByIdentity(t, "pinecone_api_key", map[string]tftypes.Value{
+		"project_id": tftypes.NewValue(tftypes.String, nil),
+		"id":         tftypes.NewValue(tftypes.String, "mock-key-id"),
+	})
+	if len(diags) == 0 {
+		t.Fatal("expected an error importing an API key without a project ID")
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &CollectionListResource{}
+var _ list.ListResourceWithConfigure = &CollectionListResource{}
+
+func NewCollectionListResource() list.ListResource {
+	return &CollectionListResource{CollectionResource: &CollectionResource{PineconeResource: &PineconeResource{}}}
+}
+
+// CollectionListResource lists the collections of the project, for `terraform query`.
+type CollectionListResource struct {
+	*CollectionResource
+}
+
+func (r *CollectionListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the collections in your project, sorted by name. The filters are the same as the ones of the `pinecone_collections` data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only list collections with this status. You can use 'Initializing', 'Ready' or 'Terminating'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"Initializing", "Ready", "Terminating"}...),
+				},
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "Only list collections hosted in this environment.",
+				Optional:            true,
+			},
+			"dimension": schema.Int32Attribute{
+				MarkdownDescription: "Only list collections of vectors with this dimension.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"min_size": schema.Int64Attribute{
+				MarkdownDescription: "Only list collections of at least this size, in bytes.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (r *CollectionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data models.CollectionFilterModel
+	var diags diag.Diagnostics
+
+	// Read Terraform configuration data into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if r.client == nil {
+		diags.AddError("Client not configured", "An API key is required to list collections.")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	collections, err := r.client.ListCollections(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to ListCollections, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	sortCollections(collections)
+
+	projectId := r.providerProjectId()
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, collection := range collections {
+			if !collectionMatches(data, collection) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = collection.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, models.CollectionIdentityModel{ProjectId: projectId, Name: types.StringValue(collection.Name)})...)
+
+			if req.IncludeResource {
+				// Listed collections have no prior value for provider-side settings, so use the
+				// schema defaults, as for imported collections.
+				model := models.CollectionResourceModel{
+					PreventDestroyIfUsed: types.BoolValue(false),
+					WaitForReady:         types.BoolValue(true),
+				}
+				var timeoutsDiags diag.Diagnostics
+				model.Timeouts, timeoutsDiags = nullTimeouts(ctx, req)
+				result.Diagnostics.Append(timeoutsDiags...)
+				model.Read(collection)
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCollectionListResource_mock(t *testing.T) {
+	server := newMockPineconeServer(t)
+	server.AddCollection("", "collection-b", 8)
+	server.AddCollection("", "collection-a", 8)
+	server.AddCollection("", "collection-c", 16)
+
+	results := server.List(t, "pinecone_collection", map[string]tftypes.Value{
+		"dimension": tftypes.NewValue(tftypes.Number, 8),
+	}, true, 0)
+
+	var names []string
+	for _, result := range results {
+		for _, d := range result.Diagnostics {
+			t.Fatalf("unexpected diagnostic for %s: %s: %s", result.DisplayName, d.Summary, d.Detail)
+		}
+		names = append(names, result.DisplayName)
+
+		if !result.Identity["name"].Equal(tftypes.NewValue(tftypes.String, result.DisplayName)) {
+			t.Errorf("identity of %s: got %s", result.DisplayName, result.Identity["name"])
+		}
+		if !result.Identity["project_id"].Equal(tftypes.NewValue(tftypes.String, mockProjectId)) {
+			t.Errorf("identity project_id of %s: got %s", result.DisplayName, result.Identity["project_id"])
+		}
+		if !result.Resource["wait_for_ready"].Equal(tftypes.NewValue(tftypes.Bool, true)) {
+			t.Errorf("wait_for_ready of %s: got %s", result.DisplayName, result.Resource["wait_for_ready"])
+		}
+		if !result.Resource["status"].Equal(tftypes.NewValue(tftypes.String, "Ready")) {
+			t.Errorf("status of %s: got %s", result.DisplayName, result.Resource["status"])
+		}
+	}
+	// Collections are sorted by name.
+	if len(names) != 2 || names[0] != "collection-a" || names[1] != "collection-b" {
+		t.Errorf("expected collection-a and collection-b, got %v", names)
+	}
+}
@@ -10,10 +10,16 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
@@ -28,6 +34,7 @@ const (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CollectionResource{}
 var _ resource.ResourceWithImportState = &CollectionResource{}
+var _ resource.ResourceWithIdentity = &CollectionResource{}
 
 func NewCollectionResource() resource.Resource {
 	return &CollectionResource{PineconeResource: &PineconeResource{}}
@@ -49,37 +56,79 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Collection identifier",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the collection.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"source": schema.StringAttribute{
 				MarkdownDescription: "The name of the source index to be used as the source for the collection.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"size": schema.Int64Attribute{
 				MarkdownDescription: "The size of the collection in bytes.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"status": schema.StringAttribute{
 				MarkdownDescription: "The status of the collection.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"dimension": schema.Int32Attribute{
 				MarkdownDescription: "The dimension of the vectors stored in each record held in the collection.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplace(),
+				},
 			},
 			"vector_count": schema.Int32Attribute{
 				MarkdownDescription: "The number of records stored in the collection.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplace(),
+				},
 			},
 			"environment": schema.StringAttribute{
 				MarkdownDescription: "The environment where the collection is hosted.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prevent_destroy_if_used": schema.BoolAttribute{
+				MarkdownDescription: "Whether to refuse deleting the collection while a pod-based index still references it as its `source_collection`. " +
+					"Defaults to `false`. This setting can be changed in place without replacing the collection.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the collection to become `Ready` before completing the apply. Defaults to `true`. " +
+					"When `false`, the apply returns as soon as the collection has been requested, and `status`, `size` and `vector_count` " +
+					"are updated on subsequent refreshes.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
 			},
 		},
 		Blocks: map[string]schema.Block{
@@ -99,6 +148,21 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+func (r *CollectionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"project_id": identityschema.StringAttribute{
+				Description:       "The ID of the project the collection belongs to, as configured by the provider's `project_id`. Null when the provider has no project_id. When given on import, it must match the provider's project_id.",
+				OptionalForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "The name of the collection.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data models.CollectionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -106,37 +170,90 @@ func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Create() is passed a default timeout to use if no value
+	// has been supplied in the Terraform configuration. The timeout covers
+	// both waiting for the source index and waiting for the collection.
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCollectionCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deadline := time.Now().Add(createTimeout)
+
+	// Wait for the source index to be stable. Collections can only be created
+	// from indexes that are Ready, e.g. not while they are still initializing or scaling.
+	err := retry.RetryContext(ctx, time.Until(deadline), func() *retry.RetryError {
+		index, err := r.client.DescribeIndex(ctx, data.Source.ValueString())
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if index.Status == nil || !index.Status.Ready {
+			var state pinecone.IndexStatusState
+			if index.Status != nil {
+				state = index.Status.State
+			}
+			tflog.Info(ctx, fmt.Sprintf("Waiting for source index '%s' to become ready. State: '%s'", index.Name, state))
+			return retry.RetryableError(fmt.Errorf("source index not ready. State: %s", state))
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to wait for source index to become ready.", err.Error())
+		return
+	}
+
 	payload := pinecone.CreateCollectionRequest{
 		Name:   data.Name.ValueString(),
 		Source: data.Source.ValueString(),
 	}
 
-	_, err := r.client.CreateCollection(ctx, &payload)
+	_, err = r.client.CreateCollection(ctx, &payload)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create collection", err.Error())
 		return
 	}
 
-	// Wait for collection to be ready
-	// Create() is passed a default timeout to use if no value
-	// has been supplied in the Terraform configuration.
-	createTimeout, diags := data.Timeouts.Create(ctx, defaultCollectionCreateTimeout)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	// The collection exists from here on, so record its identity even if waiting for it fails.
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.CollectionIdentityModel{ProjectId: r.providerProjectId(), Name: data.Name})...)
+
+	// Don't block the apply on the copy when the user opted out of waiting.
+	// Subsequent refreshes pick up status, size and vector_count as they change.
+	if !data.WaitForReady.ValueBool() {
+		collection, err := r.client.DescribeCollection(ctx, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to describe collection", err.Error())
+			return
+		}
+		data.Read(collection)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
 
-	err = retry.RetryContext(ctx, createTimeout, func() *retry.RetryError {
+	// Wait for collection to be ready
+	var lastSize int64 = -1
+	var lastVectorCount int32 = -1
+	err = retry.RetryContext(ctx, time.Until(deadline), func() *retry.RetryError {
 		collection, err := r.client.DescribeCollection(ctx, data.Name.ValueString())
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if collection == nil {
+			return retry.RetryableError(fmt.Errorf("collection %s not yet described", data.Name.ValueString()))
+		}
+
+		if collection.Size != lastSize || collection.VectorCount != lastVectorCount {
+			tflog.Info(ctx, fmt.Sprintf("Creating Collection. Status: '%s', size: %d bytes, vector_count: %d", collection.Status, collection.Size, collection.VectorCount))
+			lastSize, lastVectorCount = collection.Size, collection.VectorCount
+		}
 
 		data.Read(collection)
 		// Save current status to state
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-
-		if err != nil {
-			return retry.NonRetryableError(err)
+		if resp.Diagnostics.HasError() {
+			return retry.NonRetryableError(fmt.Errorf("setting state: %v", resp.Diagnostics))
 		}
-		if collection.Status != "Ready" {
+
+		if collection.Status != pinecone.CollectionStatusReady {
 			return retry.RetryableError(fmt.Errorf("collection not ready. State: %s", collection.Status))
 		}
 		return nil
@@ -158,20 +275,52 @@ func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// The identity only depends on the prior state, where the ID of a collection is its name, and on
+	// the prior identity's project. It is set before describing the collection, as it is also required
+	// when the collection no longer exists.
+	projectId, diags := r.projectIdentity(ctx, req.Identity)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.CollectionIdentityModel{ProjectId: projectId, Name: data.Id})...)
+
 	collection, err := r.client.DescribeCollection(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to describe collection", err.Error())
+		// The collection was deleted outside of Terraform, so drop it from state
+		// and let the next plan recreate it.
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+		} else {
+			resp.Diagnostics.AddError("Failed to describe collection", err.Error())
+		}
 		return
 	}
 
 	data.Read(collection)
 
+	// Imported collections have no prior value for provider-side settings, so fall back to the schema defaults.
+	if data.PreventDestroyIfUsed.IsNull() || data.PreventDestroyIfUsed.IsUnknown() {
+		data.PreventDestroyIfUsed = types.BoolValue(false)
+	}
+	if data.WaitForReady.IsNull() || data.WaitForReady.IsUnknown() {
+		data.WaitForReady = types.BoolValue(true)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Collections currently do not support updates
-	resp.Diagnostics.Append(diag.NewErrorDiagnostic("not supported", "This resource's Update method should not have been called"))
+	// Collections cannot be modified in Pinecone and every collection attribute requires
+	// replacement, so the only in-place changes left are provider-side settings such as
+	// prevent_destroy_if_used, wait_for_ready and timeouts. Those only need to be persisted to state.
+	var data models.CollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	projectId, diags := r.projectIdentity(ctx, req.Identity)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.CollectionIdentityModel{ProjectId: projectId, Name: data.Name})...)
 }
 
 func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -181,6 +330,36 @@ func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	if data.PreventDestroyIfUsed.ValueBool() {
+		indexes, err := r.client.ListIndexes(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list indexes", err.Error())
+			return
+		}
+
+		var usedBy []string
+		for _, index := range indexes {
+			if index.Spec == nil || index.Spec.Pod == nil || index.Spec.Pod.SourceCollection == nil {
+				continue
+			}
+			if index.Status != nil && index.Status.State == pinecone.Terminating {
+				continue
+			}
+			if *index.Spec.Pod.SourceCollection == data.Name.ValueString() {
+				usedBy = append(usedBy, index.Name)
+			}
+		}
+
+		if len(usedBy) > 0 {
+			resp.Diagnostics.AddError(
+				"Collection is still in use",
+				fmt.Sprintf("Collection %q is the source_collection of the following pod-based indexes: %s. "+
+					"Delete those indexes first, or set prevent_destroy_if_used to false.", data.Name.ValueString(), strings.Join(usedBy, ", ")),
+			)
+			return
+		}
+	}
+
 	err := r.client.DeleteCollection(ctx, data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to delete collection", err.Error())
@@ -199,7 +378,7 @@ func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		collection, err := r.client.DescribeCollection(ctx, data.Name.ValueString())
 
 		if err != nil {
-			if strings.Contains(err.Error(), "404") {
+			if isNotFoundError(err) {
 				return nil
 			}
 			return retry.NonRetryableError(err)
@@ -214,5 +393,15 @@ func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *CollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import by collection name, or by identity with an import block.
+	// The API key only reaches the provider's project, so an identity naming another one is rejected.
+	if req.ID == "" {
+		var identity models.CollectionIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(r.checkImportProject(identity.ProjectId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("name"), req, resp)
 }
@@ -5,8 +5,10 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -28,6 +30,23 @@ func TestAccCollectionResource(t *testing.T) {
 					resource.TestCheckResourceAttr("pinecone_collection.test", "source", rName),
 					resource.TestCheckResourceAttrSet("pinecone_collection.test", "size"),
 					resource.TestCheckResourceAttrSet("pinecone_collection.test", "status"),
+					resource.TestCheckResourceAttr("pinecone_collection.test", "prevent_destroy_if_used", "false"),
+					resource.TestCheckResourceAttr("pinecone_collection.test", "wait_for_ready", "true"),
+					resource.TestCheckResourceAttr("pinecone_collection.test", "status", "Ready"),
+				),
+			},
+			// Toggling the deletion guard is applied in place without replacing the collection.
+			{
+				Config: testAccCollectionResourceWithGuardConfig(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_collection.test", "id", rName),
+					resource.TestCheckResourceAttr("pinecone_collection.test", "prevent_destroy_if_used", "true"),
+				),
+			},
+			{
+				Config: testAccCollectionResourceWithGuardConfig(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_collection.test", "prevent_destroy_if_used", "false"),
 				),
 			},
 			// Verify the data source reads back the same collection without creating new infrastructure.
@@ -76,6 +95,30 @@ resource "pinecone_collection" "test" {
 `, name, name)
 }
 
+func testAccCollectionResourceWithGuardConfig(name string, preventDestroyIfUsed bool) string {
+	return fmt.Sprintf(`
+provider "pinecone" {
+}
+
+resource "pinecone_index" "test" {
+	name = %[1]q
+	dimension = 1536
+	spec = {
+		pod = {
+			environment = "us-west4-gcp"
+			pod_type = "s1.x1"
+		}
+	}
+}
+  
+resource "pinecone_collection" "test" {
+	name = %[1]q
+	source = pinecone_index.test.name
+	prevent_destroy_if_used = %[2]t
+}
+`, name, preventDestroyIfUsed)
+}
+
 func testAccCollectionResourceWithDataSourceConfig(name string) string {
 	return fmt.Sprintf(`
 provider "pinecone" {
@@ -102,3 +145,221 @@ data "pinecone_collection" "test" {
 }
 `, name, name)
 }
+
+func TestCollectionResource_mock(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		CheckDestroy:             server.CheckDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+resource "pinecone_index" "test" {
+  name      = "mock-index"
+  dimension = 8
+  spec = {
+    pod = {
+      environment = "us-west4-gcp"
+      pod_type    = "s1.x1"
+    }
+  }
+}
+
+resource "pinecone_collection" "test" {
+  name   = "mock-collection"
+  source = pinecone_index.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_collection.test", "id", "mock-collection"),
+					resource.TestCheckResourceAttr("pinecone_collection.test", "source", "mock-index"),
+					resource.TestCheckResourceAttr("pinecone_collection.test", "dimension", "8"),
+					resource.TestCheckResourceAttr("pinecone_collection.test", "environment", "us-west4-gcp"),
+					resource.TestCheckResourceAttr("pinecone_collection.test", "status", "Ready"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestCollectionResource_mock_preventDestroyIfUsed(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	config := testMockCollectionResourceConfig(server, "prevent_destroy_if_used = true")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		CheckDestroy:             server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("pinecone_collection.test", "prevent_destroy_if_used", "true"),
+			},
+			// A collection restored into a live pod index is not deleted
+			{
+				PreConfig:   func() { server.AddIndexFromCollection("", "mock-restored", "mock-collection") },
+				Config:      config,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`(?s)Collection is still in use.*mock-restored`),
+			},
+			// Once the index is gone, the collection can be deleted
+			{
+				PreConfig: func() { server.RemoveIndex("", "mock-restored") },
+				Config:    config,
+			},
+		},
+	})
+}
+
+func TestCollectionResource_mock_deletedOutsideTerraform(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	config := testMockCollectionResourceConfig(server, "")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		CheckDestroy:             server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// A collection deleted outside of Terraform is dropped from state and planned for creation again
+			{
+				PreConfig:          func() { server.RemoveCollection("", "mock-collection") },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("pinecone_collection.test", "status", "Ready"),
+			},
+		},
+	})
+}
+
+func testMockCollectionResourceConfig(server *mockPineconeServer, extra string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "pinecone_index" "test" {
+  name      = "mock-index"
+  dimension = 8
+  spec = {
+    pod = {
+      environment = "us-west4-gcp"
+      pod_type    = "s1.x1"
+    }
+  }
+}
+
+resource "pinecone_collection" "test" {
+  name   = "mock-collection"
+  source = pinecone_index.test.name
+  %s
+}
+`, extra)
+}
+
+func TestCollectionResource_mock_importByIdentity(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	server.AddCollection("", "mock-collection", 8)
+
+	state, identity, diags := server.ImportByIdentity(t, "pinecone_collection", map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "mock-collection"),
+	})
+	for _, d := range diags {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if !state["id"].Equal(tftypes.NewValue(tftypes.String, "mock-collection")) {
+		t.Errorf("id: got %s", state["id"])
+	}
+	if !state["dimension"].Equal(tftypes.NewValue(tftypes.Number, 8)) {
+		t.Errorf("dimension: got %s", state["dimension"])
+	}
+	if !identity["name"].Equal(tftypes.NewValue(tftypes.String, "mock-collection")) {
+		t.Errorf("identity name: got %s", identity["name"])
+	}
+	// The project is filled in from the provider when the identity leaves it out.
+	if !identity["project_id"].Equal(tftypes.NewValue(tftypes.String, mockProjectId)) {
+		t.Errorf("identity project_id: got %s", identity["project_id"])
+	}
+}
+
+func TestCollectionResource_mock_importByIdentityProject(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	server.AddCollection("", "mock-collection", 8)
+
+	_, identity, diags := server.ImportByIdentity(t, "pinecone_collection", map[string]tftypes.Value{
+		"project_id": tftypes.NewValue(tftypes.String, mockProjectId),
+		"name":       tftypes.NewValue(tftypes.String, "mock-collection"),
+	})
+	for _, d := range diags {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if !identity["project_id"].Equal(tftypes.NewValue(tftypes.String, mockProjectId)) {
+		t.Errorf("identity project_id: got %s", identity["project_id"])
+	}
+
+	// An identity naming another project is rejected rather than imported from the provider's.
+	_, _, diags = server.ImportByIdentity(t, "pinecone_collection", map[string]tftypes.Value{
+		"project_id": tftypes.NewValue(tftypes.String, "other-project-id"),
+		"name":       tftypes.NewValue(tftypes.String, "mock-collection"),
+	})
+	if len(diags) != 1 || diags[0].Summary != "Wrong project" {
+		t.Errorf("expected a Wrong project error, got %v", diags)
+	}
+}
+
+func TestCollectionResource_mock_noWait(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+
+	index := server.ProviderConfig() + `
+resource "pinecone_index" "test" {
+  name      = "mock-index"
+  dimension = 8
+  spec = {
+    pod = {
+      environment = "us-west4-gcp"
+      pod_type    = "s1.x1"
+    }
+  }
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		CheckDestroy:             server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: index,
+			},
+			// Create returns while the collection is still being copied, so it stays
+			// Initializing instead of being polled until Ready.
+			{
+				PreConfig: func() { server.SetReadyAfter(100) },
+				Config: index + `
+resource "pinecone_collection" "test" {
+  name           = "mock-collection"
+  source         = pinecone_index.test.name
+  wait_for_ready = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_collection.test", "id", "mock-collection"),
+					resource.TestCheckResourceAttr("pinecone_collection.test", "wait_for_ready", "false"),
+					resource.TestCheckResourceAttr("pinecone_collection.test", "status", "Initializing"),
+				),
+			},
+		},
+	})
+}
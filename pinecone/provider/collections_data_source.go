@@ -6,12 +6,18 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
 )
 
@@ -34,9 +40,34 @@ func (d *CollectionsDataSource) Metadata(ctx context.Context, req datasource.Met
 func (d *CollectionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Collections data source",
+		MarkdownDescription: "Collections data source. Collections are sorted by name.",
 
 		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only list collections with this status. You can use 'Initializing', 'Ready' or 'Terminating'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"Initializing", "Ready", "Terminating"}...),
+				},
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "Only list collections hosted in this environment.",
+				Optional:            true,
+			},
+			"dimension": schema.Int32Attribute{
+				MarkdownDescription: "Only list collections of vectors with this dimension.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"min_size": schema.Int64Attribute{
+				MarkdownDescription: "Only list collections of at least this size, in bytes.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 			"collections": schema.ListNestedAttribute{
 				MarkdownDescription: "List of the collections in your project",
 				Computed:            true,
@@ -95,7 +126,13 @@ func (d *CollectionsDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	sortCollections(collections)
+
+	data.Collections = []models.CollectionModel{}
 	for _, c := range collections {
+		if !collectionMatches(data.CollectionFilterModel, c) {
+			continue
+		}
 		data.Collections = append(data.Collections, *models.NewCollectionModel(c))
 	}
 
@@ -103,3 +140,29 @@ func (d *CollectionsDataSource) Read(ctx context.Context, req datasource.ReadReq
 	data.Id = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// sortCollections sorts collections by name, so the list does not change when the API
+// reorders them.
+func sortCollections(collections []*pinecone.Collection) {
+	sort.Slice(collections, func(i, j int) bool {
+		return collections[i].Name < collections[j].Name
+	})
+}
+
+// collectionMatches reports whether the collection passes every filter set in the data source
+// or list resource configuration.
+func collectionMatches(filter models.CollectionFilterModel, collection *pinecone.Collection) bool {
+	if !filter.Status.IsNull() && string(collection.Status) != filter.Status.ValueString() {
+		return false
+	}
+	if !filter.Environment.IsNull() && collection.Environment != filter.Environment.ValueString() {
+		return false
+	}
+	if !filter.Dimension.IsNull() && collection.Dimension != filter.Dimension.ValueInt32() {
+		return false
+	}
+	if !filter.MinSize.IsNull() && collection.Size < filter.MinSize.ValueInt64() {
+		return false
+	}
+	return true
+}
@@ -18,6 +18,12 @@ func TestAccCollectionsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.pinecone_collections.test", "id"),
 				),
 			},
+			{
+				Config: testAccCollectionsDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_collections.test", "collections.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -29,3 +35,14 @@ provider "pinecone" {
 data "pinecone_collections" "test" {
 }
 `
+
+const testAccCollectionsDataSourceFilterConfig = `
+provider "pinecone" {
+}
+
+data "pinecone_collections" "test" {
+	status    = "Ready"
+	dimension = 3
+	min_size  = 1099511627776
+}
+`
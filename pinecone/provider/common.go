@@ -3,9 +3,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 )
 
@@ -35,8 +43,9 @@ func (d *PineconeDatasource) Configure(ctx context.Context, req datasource.Confi
 }
 
 type PineconeResource struct {
-	client      *pinecone.Client
-	adminClient *pinecone.AdminClient
+	client       *pinecone.Client
+	adminClient  *pinecone.AdminClient
+	providerData *PineconeProviderData
 }
 
 func (d *PineconeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,4 +66,76 @@ func (d *PineconeResource) Configure(ctx context.Context, req resource.Configure
 
 	d.client = providerData.Client
 	d.adminClient = providerData.AdminClient
+	d.providerData = providerData
+}
+
+// providerProjectId returns the provider's project_id, or null when it is not configured.
+func (d *PineconeResource) providerProjectId() types.String {
+	if d.providerData == nil || d.providerData.projectId == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(d.providerData.projectId)
+}
+
+// projectIdentity returns the project ID recorded in the identity of a resource living in
+// the provider's project. The one of the prior identity is kept, so that the identity does
+// not change along with the provider configuration.
+func (d *PineconeResource) projectIdentity(ctx context.Context, prior *tfsdk.ResourceIdentity) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if prior != nil && !prior.Raw.IsNull() {
+		var projectId types.String
+		diags.Append(prior.GetAttribute(ctx, path.Root("project_id"), &projectId)...)
+		if diags.HasError() || !projectId.IsNull() {
+			return projectId, diags
+		}
+	}
+	return d.providerProjectId(), diags
+}
+
+// checkImportProject reports an error when the project ID of an identity being imported is
+// not the provider's project, where the API key looks the resource up.
+func (d *PineconeResource) checkImportProject(projectId types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if projectId.IsNull() || d.providerData == nil {
+		return diags
+	}
+	if d.providerData.projectId == "" {
+		diags.AddError(
+			"Unable to check the project",
+			fmt.Sprintf("The identity names project %q, but the provider has no project_id to check it against. Set project_id in the provider configuration, or leave it out of the identity.", projectId.ValueString()),
+		)
+	} else if projectId.ValueString() != d.providerData.projectId {
+		diags.AddError(
+			"Wrong project",
+			fmt.Sprintf("The identity names project %q, but the provider is configured for project %q. Import it with a provider configured for that project.", projectId.ValueString(), d.providerData.projectId),
+		)
+	}
+	return diags
+}
+
+// nullTimeouts returns a null timeouts value of the listed resource. List results start from
+// neither a plan nor a prior state, and the zero timeouts.Value lacks the attribute types.
+func nullTimeouts(ctx context.Context, req list.ListRequest) (timeouts.Value, diag.Diagnostics) {
+	typ, diags := req.ResourceSchema.TypeAtPath(ctx, path.Root("timeouts"))
+	if diags.HasError() {
+		return timeouts.Value{}, diags
+	}
+
+	value, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+	if err != nil {
+		diags.AddError("Unable to build null timeouts", err.Error())
+		return timeouts.Value{}, diags
+	}
+	return value.(timeouts.Value), diags
+}
+
+// isNotFoundError reports whether err means that the requested object does not exist.
+// The SDK only exposes the API error as text, so match the forms it takes.
+func isNotFoundError(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "not found") ||
+		strings.Contains(msg, "NOT_FOUND") ||
+		strings.Contains(msg, "404")
 }
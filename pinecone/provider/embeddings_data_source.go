@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EmbeddingsDataSource{}
+
+func NewEmbeddingsDataSource() datasource.DataSource {
+	return &EmbeddingsDataSource{PineconeDatasource: &PineconeDatasource{}}
+}
+
+// EmbeddingsDataSource defines the data source implementation.
+type EmbeddingsDataSource struct {
+	*PineconeDatasource
+}
+
+func (d *EmbeddingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_embeddings"
+}
+
+func (d *EmbeddingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The `pinecone_embeddings` data source generates embeddings for a list of texts with a model hosted by Pinecone. " +
+			"Embeddings are generated on every read, so keep the inputs small. Learn more about the Embed API in the [docs](https://docs.pinecone.io/guides/index-data/create-an-index#embedding-models).",
+
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The name of the embedding model to use.",
+				Required:            true,
+			},
+			"inputs": schema.ListAttribute{
+				MarkdownDescription: "The texts to embed.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"input_type": schema.StringAttribute{
+				MarkdownDescription: "Whether the inputs are documents or search queries. You can use 'passage' or 'query'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"passage", "query"}...),
+				},
+			},
+			"truncate": schema.StringAttribute{
+				MarkdownDescription: "How to handle inputs longer than the model supports. You can use 'END', 'START' or 'NONE'. With 'NONE', an input that is too long returns an error.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"END", "START", "NONE"}...),
+				},
+			},
+			"dimension": schema.Int32Attribute{
+				MarkdownDescription: "The dimension of the dense embeddings, for models supporting more than one dimension.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"vector_type": schema.StringAttribute{
+				MarkdownDescription: "Whether the model produced 'dense' or 'sparse' embeddings.",
+				Computed:            true,
+			},
+			"embeddings": schema.ListNestedAttribute{
+				MarkdownDescription: "The embeddings, in the same order as `inputs`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"input": schema.StringAttribute{
+							MarkdownDescription: "The embedded text.",
+							Computed:            true,
+						},
+						"values": schema.ListAttribute{
+							MarkdownDescription: "The dense embedding. Only set for dense models.",
+							Computed:            true,
+							ElementType:         types.Float64Type,
+						},
+						"sparse_values": schema.ListAttribute{
+							MarkdownDescription: "The values of the sparse embedding. Only set for sparse models.",
+							Computed:            true,
+							ElementType:         types.Float64Type,
+						},
+						"sparse_indices": schema.ListAttribute{
+							MarkdownDescription: "The indices of the sparse embedding. Only set for sparse models.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"sparse_tokens": schema.ListAttribute{
+							MarkdownDescription: "The tokens behind the sparse embedding, when returned by the model.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Embeddings identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *EmbeddingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.EmbeddingsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var inputs []string
+	resp.Diagnostics.Append(data.Inputs.ElementsAs(ctx, &inputs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters := pinecone.EmbedParameters{}
+	if !data.InputType.IsNull() {
+		parameters["input_type"] = data.InputType.ValueString()
+	}
+	if !data.Truncate.IsNull() {
+		parameters["truncate"] = data.Truncate.ValueString()
+	}
+	if !data.Dimension.IsNull() {
+		parameters["dimension"] = data.Dimension.ValueInt32()
+	}
+
+	embeddings, err := d.client.Inference.Embed(ctx, &pinecone.EmbedRequest{
+		Model:      data.Model.ValueString(),
+		TextInputs: inputs,
+		Parameters: parameters,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to generate embeddings, got error: %s", err))
+		return
+	}
+
+	if len(embeddings.Data) != len(inputs) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Expected %d embeddings, got %d", len(inputs), len(embeddings.Data)))
+		return
+	}
+
+	data.VectorType = types.StringValue(embeddings.VectorType)
+	data.Embeddings = make([]models.EmbeddingModel, 0, len(embeddings.Data))
+	for i, e := range embeddings.Data {
+		embedding, diags := models.NewEmbeddingModel(ctx, inputs[i], e)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Embeddings = append(data.Embeddings, *embedding)
+	}
+
+	// Save data into Terraform state
+	data.Id = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
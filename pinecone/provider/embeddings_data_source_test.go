@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEmbeddingsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Dense embeddings
+			{
+				Config: testAccEmbeddingsDataSourceConfig("multilingual-e5-large"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pinecone_embeddings.test", "id"),
+					resource.TestCheckResourceAttr("data.pinecone_embeddings.test", "vector_type", "dense"),
+					resource.TestCheckResourceAttr("data.pinecone_embeddings.test", "embeddings.#", "2"),
+					resource.TestCheckResourceAttr("data.pinecone_embeddings.test", "embeddings.0.values.#", "1024"),
+				),
+			},
+			// Sparse embeddings
+			{
+				Config: testAccEmbeddingsDataSourceConfig("pinecone-sparse-english-v0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_embeddings.test", "vector_type", "sparse"),
+					resource.TestCheckResourceAttr("data.pinecone_embeddings.test", "embeddings.#", "2"),
+					resource.TestCheckResourceAttrSet("data.pinecone_embeddings.test", "embeddings.0.sparse_indices.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEmbeddingsDataSourceConfig(model string) string {
+	return fmt.Sprintf(`
+	provider "pinecone" {
+	}
+
+	data "pinecone_embeddings" "test" {
+		model      = %q
+		input_type = "passage"
+		inputs     = ["The quick brown fox", "jumps over the lazy dog"]
+	}
+	`, model)
+}
@@ -5,15 +5,21 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &IndexDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &IndexDataSource{}
 
 func NewIndexDataSource() datasource.DataSource {
 	return &IndexDataSource{PineconeDatasource: &PineconeDatasource{}}
@@ -31,7 +37,7 @@ func (d *IndexDataSource) Metadata(ctx context.Context, req datasource.MetadataR
 func (d *IndexDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Index data source",
+		MarkdownDescription: "Index data source. Look up the index by exactly one of `name`, `host` or `tags`.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -40,7 +46,8 @@ func (d *IndexDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Index name",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 			},
 			"dimension": schema.Int32Attribute{
 				MarkdownDescription: "Index dimension",
@@ -59,12 +66,18 @@ func (d *IndexDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Computed:            true,
 			},
 			"tags": schema.MapAttribute{
-				Description: "Custom user tags added to an index. Keys must be 80 characters or less. Values must be 120 characters or less. Keys must be alphanumeric, '', or '-'. Values must be alphanumeric, ';', '@', '', '-', '.', '+', or ' '. To unset a key, set the value to be an empty string.",
+				Description: "Custom user tags added to an index. Keys must be 80 characters or less. Values must be 120 characters or less. Keys must be alphanumeric, '', or '-'. Values must be alphanumeric, ';', '@', '', '-', '.', '+', or ' '. When set, the index is looked up by these tags, which must match exactly one index. Terraform keeps configured values as given, so this attribute then holds only the given tags rather than every tag of the index; look the index up again by `name` to read its full tag set.",
+				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
 			"host": schema.StringAttribute{
-				MarkdownDescription: "The URL address where the index is hosted.",
+				MarkdownDescription: "The URL address where the index is hosted. When set, the index is looked up by its host.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"private_host": schema.StringAttribute{
+				MarkdownDescription: "The private endpoint URL of the index, used to reach it through AWS PrivateLink or GCP Private Service Connect. Only set when a private endpoint is configured for the project.",
 				Computed:            true,
 			},
 			"spec": schema.SingleNestedAttribute{
@@ -295,14 +308,98 @@ func (d *IndexDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	index, err := d.client.DescribeIndex(ctx, data.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to describe index", err.Error())
-		return
+	var index *pinecone.Index
+	if !data.Name.IsNull() {
+		var err error
+		index, err = d.client.DescribeIndex(ctx, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to describe index", err.Error())
+			return
+		}
+	} else {
+		index = d.findIndex(ctx, data, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
+	host, tags := data.Host, data.Tags
 	data.Read(ctx, index)
 
+	// Keep the lookup values as configured, so a host given with a scheme or a partial tag
+	// selector does not make the result differ from the configuration.
+	if !host.IsNull() {
+		data.Host = host
+	}
+	if !tags.IsNull() {
+		data.Tags = tags
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (d *IndexDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("name"),
+			path.MatchRoot("host"),
+			path.MatchRoot("tags"),
+		),
+	}
+}
+
+// findIndex looks up the index matching the configured host or tags, which must match exactly one index.
+func (d *IndexDataSource) findIndex(ctx context.Context, data models.IndexDatasourceModel, resp *datasource.ReadResponse) *pinecone.Index {
+	var filter indexFilter
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &filter.tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
+	host := normalizeIndexHost(data.Host.ValueString())
+	selector := fmt.Sprintf("tags %v", filter.tags)
+	if host != "" {
+		selector = fmt.Sprintf("host %q", host)
+	}
+
+	indexes, err := d.client.ListIndexes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to ListIndexes, got error: %s", err))
+		return nil
+	}
+
+	var matches []*pinecone.Index
+	for _, index := range indexes {
+		if host != "" && normalizeIndexHost(index.Host) != host &&
+			(index.PrivateHost == nil || normalizeIndexHost(*index.PrivateHost) != host) {
+			continue
+		}
+		if !filter.matches(index) {
+			continue
+		}
+		matches = append(matches, index)
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError("Index not found", fmt.Sprintf("No index matches %s.", selector))
+		return nil
+	case 1:
+		return matches[0]
+	default:
+		names := make([]string, 0, len(matches))
+		for _, index := range matches {
+			names = append(names, index.Name)
+		}
+		resp.Diagnostics.AddError("Multiple indexes found", fmt.Sprintf("%d indexes match %s: %s. Narrow the selector so it matches exactly one index.", len(matches), selector, strings.Join(names, ", ")))
+		return nil
+	}
+}
+
+// normalizeIndexHost strips the scheme and trailing slash from an index host.
+func normalizeIndexHost(host string) string {
+	host = strings.TrimPrefix(host, "https://")
+	host = strings.TrimPrefix(host, "http://")
+	return strings.TrimSuffix(host, "/")
+}
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -118,6 +119,72 @@ func TestAccIndexDataSource_serverless_withReadCapacity(t *testing.T) {
 	})
 }
 
+func TestAccIndexDataSource_lookup(t *testing.T) {
+	t.Parallel()
+	rName := acctest.RandomWithPrefix("tftest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Lookup by host and by tags
+			{
+				Config: testAccIndexDataSourceConfig_lookup(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_index.by_host", "name", rName),
+					resource.TestCheckResourceAttrPair("data.pinecone_index.by_host", "host", "pinecone_index.test", "host"),
+					resource.TestCheckResourceAttr("data.pinecone_index.by_tags", "name", rName),
+					resource.TestCheckResourceAttr("data.pinecone_index.by_tags", "dimension", "1024"),
+				),
+			},
+			// A selector matching no index
+			{
+				Config:      testAccIndexDataSourceConfig_lookup(rName) + testAccIndexDataSourceConfig_lookupNone,
+				ExpectError: regexp.MustCompile("Index not found"),
+			},
+		},
+	})
+}
+
+func testAccIndexDataSourceConfig_lookup(name string) string {
+	return fmt.Sprintf(`
+provider "pinecone" {
+}
+
+resource "pinecone_index" "test" {
+  name      = %q
+  dimension = 1024
+  spec = {
+    serverless = {
+      cloud  = "aws"
+      region = "us-west-2"
+    }
+  }
+  tags = {
+    lookup = %q
+  }
+}
+
+data "pinecone_index" "by_host" {
+  host = pinecone_index.test.host
+}
+
+data "pinecone_index" "by_tags" {
+  tags = {
+    lookup = pinecone_index.test.tags.lookup
+  }
+}
+`, name, name)
+}
+
+const testAccIndexDataSourceConfig_lookupNone = `
+data "pinecone_index" "none" {
+  tags = {
+    lookup = "does-not-exist"
+  }
+}
+`
+
 func testAccIndexDataSourceConfig_serverlessIntegrated(name string) string {
 	return fmt.Sprintf(`
 provider "pinecone" {
@@ -230,3 +297,71 @@ data "pinecone_index" "test" {
 }
 `, name)
 }
+
+func TestIndexDataSource_mock_privateHost(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	server.AddIndex("", "public-index")
+	server.EnablePrivateEndpoint("")
+	server.AddIndex("", "private-index")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+data "pinecone_index" "public" {
+  name = "public-index"
+}
+
+data "pinecone_index" "private" {
+  name = "private-index"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("data.pinecone_index.public", "private_host"),
+					resource.TestCheckResourceAttr("data.pinecone_index.private", "private_host", "private-index-mock.svc.private.pinecone.io"),
+				),
+			},
+		},
+	})
+}
+
+func TestIndexDataSource_mock_multipleMatches(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	server.AddIndex("", "first-index")
+	server.TagIndex("", "first-index", map[string]string{"team": "search"})
+	server.AddIndex("", "second-index")
+	server.TagIndex("", "second-index", map[string]string{"team": "search"})
+	server.AddIndex("", "other-index")
+	server.TagIndex("", "other-index", map[string]string{"team": "ranking"})
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+data "pinecone_index" "test" {
+  tags = {
+    team = "search"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Multiple indexes found(.|\n)*2 indexes match`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+data "pinecone_index" "test" {
+  tags = {
+    team = "ranking"
+  }
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.pinecone_index.test", "name", "other-index"),
+			},
+		},
+	})
+}
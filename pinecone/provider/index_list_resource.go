@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &IndexListResource{}
+var _ list.ListResourceWithConfigure = &IndexListResource{}
+
+func NewIndexListResource() list.ListResource {
+	return &IndexListResource{IndexResource: &IndexResource{PineconeResource: &PineconeResource{}}}
+}
+
+// IndexListResource lists the indexes of the project, for `terraform query`.
+type IndexListResource struct {
+	*IndexResource
+}
+
+func (r *IndexListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the indexes in your project. The filters are the same as the ones of the `pinecone_indexes` data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only list indexes whose name starts with this prefix.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list indexes whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Only list indexes having all of these tags with these values.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"spec_type": schema.StringAttribute{
+				MarkdownDescription: "Only list indexes of this deployment type. You can use 'pod', 'serverless' or 'byoc'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"pod", "serverless", "byoc"}...),
+				},
+			},
+			"cloud": schema.StringAttribute{
+				MarkdownDescription: "Only list serverless indexes hosted in this cloud. You can use 'aws', 'gcp' or 'azure'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"aws", "gcp", "azure"}...),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Only list serverless indexes hosted in this region.",
+				Optional:            true,
+			},
+			"vector_type": schema.StringAttribute{
+				MarkdownDescription: "Only list indexes of this vector type. You can use 'dense' or 'sparse'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"dense", "sparse"}...),
+				},
+			},
+			"ready_only": schema.BoolAttribute{
+				MarkdownDescription: "Only list indexes that are ready.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *IndexListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data models.IndexFilterModel
+	var diags diag.Diagnostics
+
+	// Read Terraform configuration data into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter, filterDiags := newIndexFilter(ctx, data)
+	diags.Append(filterDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if r.client == nil {
+		diags.AddError("Client not configured", "An API key is required to list indexes.")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	indexes, err := r.client.ListIndexes(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to ListIndexes, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	projectId := r.providerProjectId()
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, index := range indexes {
+			if !filter.matches(index) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = index.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, models.IndexIdentityModel{ProjectId: projectId, Name: types.StringValue(index.Name)})...)
+
+			if req.IncludeResource {
+				var model models.IndexResourceModel
+				var timeoutsDiags diag.Diagnostics
+				model.Timeouts, timeoutsDiags = nullTimeouts(ctx, req)
+				result.Diagnostics.Append(timeoutsDiags...)
+				result.Diagnostics.Append(model.Read(ctx, index)...)
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
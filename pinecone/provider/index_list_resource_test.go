@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestIndexListResource_mock(t *testing.T) {
+	server := newMockPineconeServer(t)
+	server.AddIndex("", "prod-search")
+	server.AddIndex("", "prod-rag")
+	server.AddIndex("", "staging-search")
+	server.AddIndex("other-project", "prod-other")
+
+	results := server.List(t, "pinecone_index", map[string]tftypes.Value{
+		"name_prefix": tftypes.NewValue(tftypes.String, "prod-"),
+	}, true, 0)
+
+	names := map[string]bool{}
+	for _, result := range results {
+		for _, d := range result.Diagnostics {
+			t.Fatalf("unexpected diagnostic for %s: %s: %s", result.DisplayName, d.Summary, d.Detail)
+		}
+		names[result.DisplayName] = true
+
+		if !result.Identity["name"].Equal(tftypes.NewValue(tftypes.String, result.DisplayName)) {
+			t.Errorf("identity of %s: got %s", result.DisplayName, result.Identity["name"])
+		}
+		if !result.Identity["project_id"].Equal(tftypes.NewValue(tftypes.String, mockProjectId)) {
+			t.Errorf("identity project_id of %s: got %s", result.DisplayName, result.Identity["project_id"])
+		}
+		if !result.Resource["name"].Equal(tftypes.NewValue(tftypes.String, result.DisplayName)) {
+			t.Errorf("resource name of %s: got %s", result.DisplayName, result.Resource["name"])
+		}
+		if !result.Resource["deletion_protection"].Equal(tftypes.NewValue(tftypes.String, "enabled")) {
+			t.Errorf("deletion_protection of %s: got %s", result.DisplayName, result.Resource["deletion_protection"])
+		}
+	}
+	if len(names) != 2 || !names["prod-search"] || !names["prod-rag"] {
+		t.Errorf("expected prod-search and prod-rag, got %v", names)
+	}
+}
+
+func TestIndexListResource_mock_limitWithoutResource(t *testing.T) {
+	server := newMockPineconeServer(t)
+	server.AddIndex("", "index-a")
+	server.AddIndex("", "index-b")
+	server.AddIndex("", "index-c")
+
+	results := server.List(t, "pinecone_index", nil, false, 2)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	for _, result := range results {
+		if result.Identity["name"].IsNull() {
+			t.Errorf("missing identity for %s", result.DisplayName)
+		}
+		if result.Resource != nil {
+			t.Errorf("unexpected resource for %s", result.DisplayName)
+		}
+	}
+}
+
+func TestIndexListResource_mock_invalidFilter(t *testing.T) {
+	server := newMockPineconeServer(t)
+
+	results := server.List(t, "pinecone_index", map[string]tftypes.Value{
+		"name_regex": tftypes.NewValue(tftypes.String, "("),
+	}, false, 0)
+	if len(results) != 1 || len(results[0].Diagnostics) != 1 || results[0].Diagnostics[0].Summary != "Invalid name_regex" {
+		t.Fatalf("expected an Invalid name_regex diagnostic, got %+v", results)
+	}
+}
@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -41,6 +43,8 @@ const (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IndexResource{}
 var _ resource.ResourceWithImportState = &IndexResource{}
+var _ resource.ResourceWithModifyPlan = &IndexResource{}
+var _ resource.ResourceWithIdentity = &IndexResource{}
 
 func NewIndexResource() resource.Resource {
 	return &IndexResource{PineconeResource: &PineconeResource{}}
@@ -139,6 +143,13 @@ func (r *IndexResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"private_host": schema.StringAttribute{
+				MarkdownDescription: "The private endpoint URL of the index, used to reach it through AWS PrivateLink or GCP Private Service Connect. Only set when a private endpoint is configured for the project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"spec": schema.SingleNestedAttribute{
 				Description: "Spec",
 				Optional:    true,
@@ -365,6 +376,21 @@ Refer to the [model guide](https://docs.pinecone.io/guides/inference/understandi
 	}
 }
 
+func (r *IndexResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"project_id": identityschema.StringAttribute{
+				Description:       "The ID of the project the index belongs to, as configured by the provider's `project_id`. Null when the provider has no project_id. When given on import, it must match the provider's project_id.",
+				OptionalForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "The name of the index.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *IndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data models.IndexResourceModel
 
@@ -460,12 +486,10 @@ func (r *IndexResource) Create(ctx context.Context, req resource.CreateRequest,
 			podReq.SourceCollection = spec.Pod.SourceCollection.ValueStringPointer()
 		}
 
-		var metadataConfig *pinecone.PodSpecMetadataConfig
-		if !spec.Pod.MetadataConfig.IsUnknown() {
-			resp.Diagnostics.Append(spec.Pod.MetadataConfig.As(ctx, &metadataConfig, basetypes.ObjectAsOptions{})...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
+		metadataConfig, diags := models.ToPodSpecMetadataConfig(ctx, spec.Pod.MetadataConfig)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 		podReq.MetadataConfig = metadataConfig
 
@@ -599,6 +623,9 @@ func (r *IndexResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
+	// The index exists from here on, so record its identity even if waiting for it fails.
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.IndexIdentityModel{ProjectId: r.providerProjectId(), Name: data.Name})...)
+
 	// Wait for index to be ready
 	// Create() is passed a default timeout to use if no value
 	// has been supplied in the Terraform configuration.
@@ -667,6 +694,13 @@ func (r *IndexResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// The identity only depends on the prior state, where the ID of an index is its name, and on
+	// the prior identity's project. It is set before describing the index, as it is also required
+	// when the index no longer exists.
+	projectId, diags := r.projectIdentity(ctx, req.Identity)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.IndexIdentityModel{ProjectId: projectId, Name: data.Id})...)
+
 	// Capture prior embed to restore user-configured read/write parameters after the
 	// API read overwrites them. effective_* will reflect the new full API response.
 	var priorEmbedModel *models.IndexEmbedResourceModel
@@ -866,6 +900,9 @@ func (r *IndexResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newData)...)
+	projectId, diags := r.projectIdentity(ctx, req.Identity)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.IndexIdentityModel{ProjectId: projectId, Name: newData.Name})...)
 }
 
 func (r *IndexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -911,8 +948,181 @@ func (r *IndexResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+func (r *IndexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the index is being destroyed.
+	if req.Plan.Raw.IsNull() || r.providerData == nil || r.client == nil {
+		return
+	}
+
+	var plan models.IndexResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Embed.IsNull() || plan.Embed.IsUnknown() {
+		return
+	}
+
+	var embed models.IndexEmbedResourceModel
+	resp.Diagnostics.Append(plan.Embed.As(ctx, &embed, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || embed.Model.IsNull() || embed.Model.IsUnknown() {
+		return
+	}
+
+	// The model catalog is only consulted when the index is created or its embed
+	// configuration changes, so plans of unchanged indexes make no inference requests.
+	if !req.State.Raw.IsNull() {
+		var state models.IndexResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		var stateEmbed models.IndexEmbedResourceModel
+		if !state.Embed.IsNull() {
+			resp.Diagnostics.Append(state.Embed.As(ctx, &stateEmbed, basetypes.ObjectAsOptions{})...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		if embed.Model.Equal(stateEmbed.Model) &&
+			embed.FieldMap.Equal(stateEmbed.FieldMap) &&
+			plan.Dimension.Equal(state.Dimension) &&
+			plan.Metric.Equal(state.Metric) &&
+			plan.VectorType.Equal(state.VectorType) {
+			return
+		}
+	}
+
+	// Check the embed configuration against the model catalog, so a mismatched dimension or
+	// metric is reported at plan time instead of failing Create.
+	modelPath := path.Root("embed").AtName("model")
+	modelName := embed.Model.ValueString()
+	model, err := r.providerData.describeModel(ctx, modelName)
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.Diagnostics.AddAttributeError(
+				modelPath,
+				"Unknown embedding model",
+				fmt.Sprintf("Model %q is not hosted by Pinecone. Use the pinecone_inference_models data source to list the available models.", modelName),
+			)
+			return
+		}
+		resp.Diagnostics.AddAttributeWarning(
+			modelPath,
+			"Unable to validate embedding model",
+			fmt.Sprintf("Could not describe model %q: %s", modelName, err),
+		)
+		return
+	}
+
+	if model.Type != "embed" {
+		resp.Diagnostics.AddAttributeError(
+			modelPath,
+			"Invalid embedding model",
+			fmt.Sprintf("Model %q is a %s model and cannot be used to embed records. Use a model of type 'embed'.", modelName, model.Type),
+		)
+		return
+	}
+
+	vectorType := ""
+	if model.VectorType != nil {
+		vectorType = *model.VectorType
+	}
+
+	if !plan.VectorType.IsNull() && !plan.VectorType.IsUnknown() && vectorType != "" && plan.VectorType.ValueString() != vectorType {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("vector_type"),
+			"Invalid vector_type for embedding model",
+			fmt.Sprintf("Model %q produces %s embeddings, but vector_type is %q.", modelName, vectorType, plan.VectorType.ValueString()),
+		)
+	}
+
+	if !plan.Dimension.IsNull() && !plan.Dimension.IsUnknown() {
+		dimension := plan.Dimension.ValueInt32()
+		if vectorType == "sparse" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("dimension"),
+				"Invalid dimension for embedding model",
+				fmt.Sprintf("Model %q produces sparse embeddings, so dimension must not be set.", modelName),
+			)
+		} else if model.SupportedDimensions != nil && !slices.Contains(*model.SupportedDimensions, dimension) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("dimension"),
+				"Invalid dimension for embedding model",
+				fmt.Sprintf("Model %q supports dimensions %v, got %d.", modelName, *model.SupportedDimensions, dimension),
+			)
+		}
+	}
+
+	// metric always has a value in the plan because of its default, so only check it when
+	// it is set explicitly in the configuration.
+	var metric types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metric"), &metric)...)
+	if !metric.IsNull() && !metric.IsUnknown() && model.SupportedMetrics != nil &&
+		!slices.Contains(*model.SupportedMetrics, pinecone.IndexMetric(metric.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("metric"),
+			"Invalid metric for embedding model",
+			fmt.Sprintf("Model %q supports metrics %v, got %q.", modelName, *model.SupportedMetrics, metric.ValueString()),
+		)
+	}
+
+	fieldMapPath := path.Root("embed").AtName("field_map")
+	if embed.FieldMap.IsNull() || (embed.FieldMap.IsUnknown() && req.State.Raw.IsNull()) {
+		var fieldMap types.Map
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, fieldMapPath, &fieldMap)...)
+		if fieldMap.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				fieldMapPath,
+				"Missing field_map",
+				fmt.Sprintf("An index using model %q needs a field_map naming the record field to embed, e.g. { text = \"chunk_text\" }.", modelName),
+			)
+		}
+	} else if !embed.FieldMap.IsUnknown() && (model.Modality == nil || *model.Modality == "text") {
+		if _, ok := embed.FieldMap.Elements()["text"]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				fieldMapPath,
+				"Invalid field_map",
+				fmt.Sprintf("Model %q embeds text, so field_map must have a \"text\" key naming the record field to embed, e.g. { text = \"chunk_text\" }.", modelName),
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fill in the computed embed attributes the model determines, instead of leaving them
+	// unknown until apply.
+	if embed.VectorType.IsUnknown() && vectorType != "" {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("embed").AtName("vector_type"), vectorType)...)
+	}
+	if embed.Dimension.IsUnknown() {
+		dimension := types.Int32Unknown()
+		if vectorType == "sparse" {
+			dimension = types.Int32Null()
+		} else if !plan.Dimension.IsNull() && !plan.Dimension.IsUnknown() {
+			dimension = plan.Dimension
+		} else if model.DefaultDimension != nil {
+			dimension = types.Int32Value(*model.DefaultDimension)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("embed").AtName("dimension"), dimension)...)
+	}
+}
+
 func (r *IndexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import by index name, or by identity with an import block.
+	// The API key only reaches the provider's project, so an identity naming another one is rejected.
+	if req.ID == "" {
+		var identity models.IndexIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(r.checkImportProject(identity.ProjectId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("name"), req, resp)
 }
 
 func mergeTags(oldTags, newTags map[string]string) map[string]string {
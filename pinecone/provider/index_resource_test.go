@@ -6,10 +6,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -317,6 +319,65 @@ resource "pinecone_index" "test" {
 	})
 }
 
+func TestAccIndexResource_serverless_unknownEmbedModel(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(),
+		Steps: []resource.TestStep{{
+			Config: `
+resource "pinecone_index" "test" {
+  name = "test"
+  spec = {
+	serverless = {
+		cloud = "aws"
+		region = "us-east-1"
+	}
+  }
+  embed = {
+    model = "not-a-real-model"
+	field_map = {
+		text = "chunk_text"
+	}
+  }
+}`,
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("Unknown embedding model"),
+		}},
+	})
+}
+
+func TestAccIndexResource_serverless_invalidEmbedDimension(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(),
+		Steps: []resource.TestStep{{
+			Config: `
+resource "pinecone_index" "test" {
+  name = "test"
+  dimension = 1536
+  spec = {
+	serverless = {
+		cloud = "aws"
+		region = "us-east-1"
+	}
+  }
+  embed = {
+    model = "multilingual-e5-large"
+	field_map = {
+		text = "chunk_text"
+	}
+  }
+}`,
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("Invalid dimension for embedding model"),
+		}},
+	})
+}
+
 func TestAccIndexResource_serverless_integratedWithExplicitDimension(t *testing.T) {
 	t.Parallel()
 	rName := acctest.RandomWithPrefix("tftest")
@@ -554,3 +615,322 @@ resource "pinecone_index" "%s" {
 }
 `, resourceName, name)
 }
+
+func TestIndexResource_mock_serverless(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		CheckDestroy:             server.CheckDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testMockIndexResourceConfig_serverless(server, "mock-index", "disabled", map[string]string{"env": "dev", "team": "search"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index.test", "id", "mock-index"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "dimension", "8"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "metric", "cosine"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "vector_type", "dense"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "host", "mock-index-mock.svc.pinecone.io"),
+					resource.TestCheckNoResourceAttr("pinecone_index.test", "private_host"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "spec.serverless.cloud", "aws"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "spec.serverless.region", "us-east-1"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "status.ready", "true"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "status.state", "Ready"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "tags.%", "2"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "tags.env", "dev"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "pinecone_index.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: testMockIndexResourceConfig_serverless(server, "mock-index", "enabled", map[string]string{"env": "prod"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index.test", "deletion_protection", "enabled"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "tags.env", "prod"),
+				),
+			},
+			// Deletion protection must be lifted before destroying the index
+			{
+				Config: testMockIndexResourceConfig_serverless(server, "mock-index", "disabled", map[string]string{"env": "prod"}),
+				Check:  resource.TestCheckResourceAttr("pinecone_index.test", "deletion_protection", "disabled"),
+			},
+		},
+	})
+}
+
+func TestIndexResource_mock_privateHost(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	server.EnablePrivateEndpoint("")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		CheckDestroy:             server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockIndexResourceConfig_serverless(server, "mock-index", "disabled", nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index.test", "host", "mock-index-mock.svc.pinecone.io"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "private_host", "mock-index-mock.svc.private.pinecone.io"),
+				),
+			},
+		},
+	})
+}
+
+func TestIndexResource_mock_podScaling(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		CheckDestroy:             server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMockIndexResourceConfig_pod(server, "mock-pod-index", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index.test", "spec.pod.environment", "us-west4-gcp"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "spec.pod.replicas", "1"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "spec.pod.pods", "1"),
+				),
+			},
+			{
+				Config: testMockIndexResourceConfig_pod(server, "mock-pod-index", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index.test", "spec.pod.replicas", "2"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "spec.pod.pods", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestIndexResource_mock_apiErrors(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	config := testMockIndexResourceConfig_serverless(server, "mock-index", "disabled", nil)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		CheckDestroy:             server.CheckDestroy,
+		Steps: []resource.TestStep{
+			// A rate limited create fails without leaving anything behind
+			{
+				PreConfig:   func() { server.FailNext(http.MethodPost, "/indexes", http.StatusTooManyRequests, 1) },
+				Config:      config,
+				ExpectError: regexp.MustCompile(`RESOURCE_EXHAUSTED`),
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("pinecone_index.test", "status.state", "Ready"),
+			},
+			// A server error while refreshing is surfaced
+			{
+				PreConfig:   func() { server.FailNext(http.MethodGet, "/indexes/mock-index", http.StatusInternalServerError, 1) },
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Injected error: internal server error`),
+			},
+			// An index deleted outside of Terraform is planned for creation again
+			{
+				PreConfig:          func() { server.FailNext(http.MethodGet, "/indexes/mock-index", http.StatusNotFound, 100) },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: server.ClearFailures,
+				Config:    config,
+				PlanOnly:  true,
+			},
+		},
+	})
+}
+
+func TestIndexResource_mock_integrated(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	config := testMockIndexResourceConfig_integrated(server, "mock-index", "multilingual-e5-large", `{ text = "chunk_text" }`, "")
+
+	var describes int
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		CheckDestroy:             server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_index.test", "dimension", "1024"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "vector_type", "dense"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "embed.model", "multilingual-e5-large"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "embed.dimension", "1024"),
+					resource.TestCheckResourceAttr("pinecone_index.test", "embed.field_map.text", "chunk_text"),
+				),
+			},
+			// Plans of an unchanged index do not describe the model again
+			{
+				PreConfig: func() { describes = server.Requests(http.MethodGet, "/models/multilingual-e5-large") },
+				Config:    config,
+				PlanOnly:  true,
+			},
+			{
+				PreConfig: func() {
+					if got := server.Requests(http.MethodGet, "/models/multilingual-e5-large"); got != describes {
+						t.Errorf("model described %d times while planning an unchanged index", got-describes)
+					}
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestIndexResource_mock_invalidEmbedConfig(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testMockIndexResourceConfig_integrated(server, "mock-index", "no-such-model", `{ text = "chunk_text" }`, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unknown embedding model"),
+			},
+			{
+				Config:      testMockIndexResourceConfig_integrated(server, "mock-index", "multilingual-e5-large", `{ body = "chunk_text" }`, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid field_map"),
+			},
+			{
+				Config:      testMockIndexResourceConfig_integrated(server, "mock-index", "multilingual-e5-large", `{ text = "chunk_text" }`, "dimension = 512"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid dimension for embedding model"),
+			},
+			{
+				Config:      testMockIndexResourceConfig_integrated(server, "mock-index", "pinecone-sparse-english-v0", `{ text = "chunk_text" }`, "dimension = 1024"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid dimension for embedding model"),
+			},
+		},
+	})
+}
+
+func testMockIndexResourceConfig_serverless(server *mockPineconeServer, name string, deletionProtection string, tags map[string]string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "pinecone_index" "test" {
+  name                = %q
+  dimension           = 8
+  deletion_protection = %q
+  spec = {
+    serverless = {
+      cloud  = "aws"
+      region = "us-east-1"
+    }
+  }
+%s
+}
+`, name, deletionProtection, convertTagsToString(tags))
+}
+
+func testMockIndexResourceConfig_pod(server *mockPineconeServer, name string, replicas int) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "pinecone_index" "test" {
+  name      = %q
+  dimension = 8
+  spec = {
+    pod = {
+      environment = "us-west4-gcp"
+      pod_type    = "s1.x1"
+      replicas    = %d
+    }
+  }
+}
+`, name, replicas)
+}
+
+func testMockIndexResourceConfig_integrated(server *mockPineconeServer, name string, model string, fieldMap string, extra string) string {
+	return server.ProviderConfig() + fmt.Sprintf(`
+resource "pinecone_index" "test" {
+  name = %q
+  %s
+  spec = {
+    serverless = {
+      cloud  = "aws"
+      region = "us-east-1"
+    }
+  }
+  embed = {
+    model     = %q
+    field_map = %s
+  }
+}
+`, name, extra, model, fieldMap)
+}
+
+func TestIndexResource_mock_importByIdentity(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	server.AddIndex("", "mock-index")
+
+	state, identity, diags := server.ImportByIdentity(t, "pinecone_index", map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "mock-index"),
+	})
+	for _, d := range diags {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if !state["id"].Equal(tftypes.NewValue(tftypes.String, "mock-index")) {
+		t.Errorf("id: got %s", state["id"])
+	}
+	if !state["dimension"].Equal(tftypes.NewValue(tftypes.Number, 8)) {
+		t.Errorf("dimension: got %s", state["dimension"])
+	}
+	if !identity["name"].Equal(tftypes.NewValue(tftypes.String, "mock-index")) {
+		t.Errorf("identity name: got %s", identity["name"])
+	}
+	// The project is filled in from the provider when the identity leaves it out.
+	if !identity["project_id"].Equal(tftypes.NewValue(tftypes.String, mockProjectId)) {
+		t.Errorf("identity project_id: got %s", identity["project_id"])
+	}
+}
+
+func TestIndexResource_mock_importByIdentityProject(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	server.AddIndex("", "mock-index")
+
+	_, identity, diags := server.ImportByIdentity(t, "pinecone_index", map[string]tftypes.Value{
+		"project_id": tftypes.NewValue(tftypes.String, mockProjectId),
+		"name":       tftypes.NewValue(tftypes.String, "mock-index"),
+	})
+	for _, d := range diags {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if !identity["project_id"].Equal(tftypes.NewValue(tftypes.String, mockProjectId)) {
+		t.Errorf("identity project_id: got %s", identity["project_id"])
+	}
+
+	// An identity naming another project is rejected rather than imported from the provider's.
+	_, _, diags = server.ImportByIdentity(t, "pinecone_index", map[string]tftypes.Value{
+		"project_id": tftypes.NewValue(tftypes.String, "other-project-id"),
+		"name":       tftypes.NewValue(tftypes.String, "mock-index"),
+	})
+	if len(diags) != 1 || diags[0].Summary != "Wrong project" {
+		t.Errorf("expected a Wrong project error, got %v", diags)
+	}
+}
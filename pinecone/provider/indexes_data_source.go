@@ -6,12 +6,19 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
 )
 
@@ -37,6 +44,53 @@ func (d *IndexesDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 		MarkdownDescription: "Indexes data source",
 
 		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only list indexes whose name starts with this prefix.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list indexes whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Only list indexes having all of these tags with these values.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"spec_type": schema.StringAttribute{
+				MarkdownDescription: "Only list indexes of this deployment type. You can use 'pod', 'serverless' or 'byoc'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"pod", "serverless", "byoc"}...),
+				},
+			},
+			"cloud": schema.StringAttribute{
+				MarkdownDescription: "Only list serverless indexes hosted in this cloud. You can use 'aws', 'gcp' or 'azure'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"aws", "gcp", "azure"}...),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Only list serverless indexes hosted in this region.",
+				Optional:            true,
+			},
+			"vector_type": schema.StringAttribute{
+				MarkdownDescription: "Only list indexes of this vector type. You can use 'dense' or 'sparse'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"dense", "sparse"}...),
+				},
+			},
+			"ready_only": schema.BoolAttribute{
+				MarkdownDescription: "Only list indexes that are ready.",
+				Optional:            true,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "The names of the listed indexes.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"indexes": schema.ListNestedAttribute{
 				MarkdownDescription: "List of the indexes in your project",
 				Computed:            true,
@@ -72,6 +126,10 @@ func (d *IndexesDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 							MarkdownDescription: "The URL address where the index is hosted.",
 							Computed:            true,
 						},
+						"private_host": schema.StringAttribute{
+							MarkdownDescription: "The private endpoint URL of the index, used to reach it through AWS PrivateLink or GCP Private Service Connect. Only set when a private endpoint is configured for the project.",
+							Computed:            true,
+						},
 						"spec": schema.SingleNestedAttribute{
 							Description: "Spec",
 							Optional:    true,
@@ -232,13 +290,25 @@ func (d *IndexesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	filter, diags := newIndexFilter(ctx, data.IndexFilterModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	indexes, err := d.client.ListIndexes(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to ListIndexes, got error: %s", err))
 		return
 	}
 
+	names := []string{}
 	for _, i := range indexes {
+		if !filter.matches(i) {
+			continue
+		}
+		names = append(names, i.Name)
+
 		index := models.IndexModel{}
 		resp.Diagnostics.Append(index.Read(ctx, i)...)
 		if resp.Diagnostics.HasError() {
@@ -247,6 +317,12 @@ func (d *IndexesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		data.Indexes = append(data.Indexes, index)
 	}
 
+	data.Names, diags = types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// For the purposes of this Indexes code, hardcoding a response value to
 	// save into the Terraform state.
 	data.Id = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
@@ -258,3 +334,93 @@ func (d *IndexesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// indexFilter selects the indexes listed by the data source and the list resource. Unset fields match every index.
+type indexFilter struct {
+	namePrefix string
+	nameRegex  *regexp.Regexp
+	tags       map[string]string
+	specType   string
+	cloud      string
+	region     string
+	vectorType string
+	readyOnly  bool
+}
+
+// newIndexFilter builds the filter described by the filter attributes of the data source or
+// list resource configuration.
+func newIndexFilter(ctx context.Context, data models.IndexFilterModel) (indexFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filter := indexFilter{
+		namePrefix: data.NamePrefix.ValueString(),
+		specType:   data.SpecType.ValueString(),
+		cloud:      data.Cloud.ValueString(),
+		region:     data.Region.ValueString(),
+		vectorType: data.VectorType.ValueString(),
+		readyOnly:  data.ReadyOnly.ValueBool(),
+	}
+
+	if !data.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return filter, diags
+		}
+		filter.nameRegex = nameRegex
+	}
+
+	diags.Append(data.Tags.ElementsAs(ctx, &filter.tags, false)...)
+
+	return filter, diags
+}
+
+func (f indexFilter) matches(index *pinecone.Index) bool {
+	if !strings.HasPrefix(index.Name, f.namePrefix) {
+		return false
+	}
+	if f.nameRegex != nil && !f.nameRegex.MatchString(index.Name) {
+		return false
+	}
+	for k, v := range f.tags {
+		if index.Tags == nil {
+			return false
+		}
+		if value, ok := (*index.Tags)[k]; !ok || value != v {
+			return false
+		}
+	}
+	if f.vectorType != "" && index.VectorType != f.vectorType {
+		return false
+	}
+	if f.readyOnly && (index.Status == nil || !index.Status.Ready) {
+		return false
+	}
+
+	var spec pinecone.IndexSpec
+	if index.Spec != nil {
+		spec = *index.Spec
+	}
+	switch f.specType {
+	case "pod":
+		if spec.Pod == nil {
+			return false
+		}
+	case "serverless":
+		if spec.Serverless == nil {
+			return false
+		}
+	case "byoc":
+		if spec.BYOC == nil {
+			return false
+		}
+	}
+	if f.cloud != "" && (spec.Serverless == nil || string(spec.Serverless.Cloud) != f.cloud) {
+		return false
+	}
+	if f.region != "" && (spec.Serverless == nil || spec.Serverless.Region != f.region) {
+		return false
+	}
+
+	return true
+}
@@ -5,10 +5,12 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 )
 
 func TestAccIndexesDataSource(t *testing.T) {
@@ -26,10 +28,72 @@ func TestAccIndexesDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.pinecone_indexes.test", "id"),
 				),
 			},
+			// Filter testing
+			{
+				Config: testAccIndexesDataSourceFilterConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_indexes.filtered", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.pinecone_indexes.filtered", "names.0", rName),
+					resource.TestCheckResourceAttr("data.pinecone_indexes.filtered", "indexes.0.name", rName),
+					resource.TestCheckResourceAttr("data.pinecone_indexes.filtered", "indexes.0.deletion_protection", "disabled"),
+					resource.TestCheckResourceAttr("data.pinecone_indexes.filtered", "indexes.0.status.ready", "true"),
+					resource.TestCheckResourceAttr("data.pinecone_indexes.none", "names.#", "0"),
+				),
+			},
 		},
 	})
 }
 
+func TestIndexFilter_matches(t *testing.T) {
+	ready := &pinecone.Index{
+		Name:       "prod-search",
+		VectorType: "dense",
+		Spec: &pinecone.IndexSpec{
+			Serverless: &pinecone.ServerlessSpec{Cloud: pinecone.Aws, Region: "us-east-1"},
+		},
+		Status: &pinecone.IndexStatus{Ready: true},
+		Tags:   &pinecone.IndexTags{"env": "prod", "team": "search"},
+	}
+	pod := &pinecone.Index{
+		Name:       "dev-pod",
+		VectorType: "dense",
+		Spec: &pinecone.IndexSpec{
+			Pod: &pinecone.PodSpec{Environment: "us-west4-gcp"},
+		},
+		Status: &pinecone.IndexStatus{Ready: false},
+	}
+
+	tests := []struct {
+		name   string
+		filter indexFilter
+		index  *pinecone.Index
+		want   bool
+	}{
+		{"empty filter", indexFilter{}, pod, true},
+		{"name prefix", indexFilter{namePrefix: "prod-"}, ready, true},
+		{"name prefix mismatch", indexFilter{namePrefix: "prod-"}, pod, false},
+		{"name regex", indexFilter{nameRegex: regexp.MustCompile("-pod$")}, pod, true},
+		{"tags", indexFilter{tags: map[string]string{"env": "prod"}}, ready, true},
+		{"tags mismatch", indexFilter{tags: map[string]string{"env": "prod", "team": "other"}}, ready, false},
+		{"tags on untagged index", indexFilter{tags: map[string]string{"env": "prod"}}, pod, false},
+		{"spec type", indexFilter{specType: "pod"}, pod, true},
+		{"spec type mismatch", indexFilter{specType: "serverless"}, pod, false},
+		{"cloud and region", indexFilter{cloud: "aws", region: "us-east-1"}, ready, true},
+		{"cloud on pod index", indexFilter{cloud: "aws"}, pod, false},
+		{"vector type mismatch", indexFilter{vectorType: "sparse"}, ready, false},
+		{"ready only", indexFilter{readyOnly: true}, ready, true},
+		{"ready only not ready", indexFilter{readyOnly: true}, pod, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.matches(tt.index); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func testAccIndexesDataSourceConfig(name string) string {
 	return fmt.Sprintf(`
 	provider "pinecone" {
@@ -50,3 +114,39 @@ func testAccIndexesDataSourceConfig(name string) string {
 	}
 	`, name)
 }
+
+func testAccIndexesDataSourceFilterConfig(name string) string {
+	return fmt.Sprintf(`
+	provider "pinecone" {
+	}
+
+	resource "pinecone_index" "test" {
+		name = %q
+		dimension = 1536
+		tags = {
+			owner = %q
+		}
+		spec = {
+		    serverless = {
+		        cloud = "aws"
+			    region = "us-west-2"
+		    }
+		}
+	}
+
+	data "pinecone_indexes" "filtered" {
+		name_prefix = "tftest"
+		spec_type   = "serverless"
+		cloud       = "aws"
+		region      = "us-west-2"
+		ready_only  = true
+		tags = {
+			owner = pinecone_index.test.tags.owner
+		}
+	}
+
+	data "pinecone_indexes" "none" {
+		name_regex = "^does-not-exist$"
+	}
+	`, name, name)
+}
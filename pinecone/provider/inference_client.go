@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+// describeModel returns the catalog entry for the named inference model. Entries are cached
+// for the lifetime of the provider instance, so a plan with many integrated indexes using the
+// same model describes it only once.
+func (p *PineconeProviderData) describeModel(ctx context.Context, name string) (*pinecone.ModelInfo, error) {
+	p.modelsMu.Lock()
+	model, ok := p.models[name]
+	p.modelsMu.Unlock()
+	if ok {
+		return model, nil
+	}
+
+	// The lock is not held while describing, so plans of other resources are not blocked
+	// on the request. Concurrent misses for the same model may each describe it.
+	model, err := p.Client.Inference.DescribeModel(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	p.modelsMu.Lock()
+	defer p.modelsMu.Unlock()
+	if p.models == nil {
+		p.models = make(map[string]*pinecone.ModelInfo)
+	}
+	p.models[name] = model
+	return model, nil
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InferenceModelsDataSource{}
+
+func NewInferenceModelsDataSource() datasource.DataSource {
+	return &InferenceModelsDataSource{PineconeDatasource: &PineconeDatasource{}}
+}
+
+// InferenceModelsDataSource defines the data source implementation.
+type InferenceModelsDataSource struct {
+	*PineconeDatasource
+}
+
+func (d *InferenceModelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inference_models"
+}
+
+func (d *InferenceModelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The `pinecone_inference_models` data source lists the embedding and reranking models hosted by Pinecone. " +
+			"Learn more about the available models in the [docs](https://docs.pinecone.io/guides/index-data/create-an-index#embedding-models).",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list models of this type. You can use 'embed' or 'rerank'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"embed", "rerank"}...),
+				},
+			},
+			"vector_type": schema.StringAttribute{
+				MarkdownDescription: "Only list embedding models producing this vector type. You can use 'dense' or 'sparse'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"dense", "sparse"}...),
+				},
+			},
+			"models": schema.ListNestedAttribute{
+				MarkdownDescription: "List of the models hosted by Pinecone.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the model, as used in `embed.model`.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of model: 'embed' or 'rerank'.",
+							Computed:            true,
+						},
+						"vector_type": schema.StringAttribute{
+							MarkdownDescription: "Whether the embedding model produces 'dense' or 'sparse' embeddings.",
+							Computed:            true,
+						},
+						"short_description": schema.StringAttribute{
+							MarkdownDescription: "A summary of the model.",
+							Computed:            true,
+						},
+						"provider_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the provider of the model.",
+							Computed:            true,
+						},
+						"modality": schema.StringAttribute{
+							MarkdownDescription: "The modality of the model, e.g. 'text'.",
+							Computed:            true,
+						},
+						"default_dimension": schema.Int32Attribute{
+							MarkdownDescription: "The default embedding dimension. Only set for dense embedding models.",
+							Computed:            true,
+						},
+						"supported_dimensions": schema.ListAttribute{
+							MarkdownDescription: "The embedding dimensions supported by the model. Only set for dense embedding models.",
+							Computed:            true,
+							ElementType:         types.Int32Type,
+						},
+						"supported_metrics": schema.ListAttribute{
+							MarkdownDescription: "The distance metrics supported by the model for similarity search.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"max_sequence_length": schema.Int32Attribute{
+							MarkdownDescription: "The maximum number of tokens per sequence supported by the model.",
+							Computed:            true,
+						},
+						"max_batch_size": schema.Int32Attribute{
+							MarkdownDescription: "The maximum number of sequences per request supported by the model.",
+							Computed:            true,
+						},
+						"supported_parameters": schema.ListNestedAttribute{
+							MarkdownDescription: "The parameters supported by the model, such as `input_type` or `truncate`.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"parameter": schema.StringAttribute{
+										MarkdownDescription: "The name of the parameter.",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "The parameter type: 'one_of', 'numeric_range' or 'any'.",
+										Computed:            true,
+									},
+									"value_type": schema.StringAttribute{
+										MarkdownDescription: "The type of value the parameter accepts, e.g. 'string', 'integer', 'float' or 'boolean'.",
+										Computed:            true,
+									},
+									"required": schema.BoolAttribute{
+										MarkdownDescription: "Whether the parameter is required.",
+										Computed:            true,
+									},
+									"allowed_values": schema.ListAttribute{
+										MarkdownDescription: "The allowed values when the type is 'one_of', rendered as strings.",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
+									"default": schema.StringAttribute{
+										MarkdownDescription: "The default value of an optional parameter, rendered as a string.",
+										Computed:            true,
+									},
+									"min": schema.Float64Attribute{
+										MarkdownDescription: "The minimum allowed value (inclusive) when the type is 'numeric_range'.",
+										Computed:            true,
+									},
+									"max": schema.Float64Attribute{
+										MarkdownDescription: "The maximum allowed value (inclusive) when the type is 'numeric_range'.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Inference models identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *InferenceModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.InferenceModelsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := pinecone.ListModelsParams{
+		Type:       data.Type.ValueStringPointer(),
+		VectorType: data.VectorType.ValueStringPointer(),
+	}
+
+	modelList, err := d.client.Inference.ListModels(ctx, &params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list inference models, got error: %s", err))
+		return
+	}
+
+	data.Models = []models.InferenceModelModel{}
+	if modelList != nil && modelList.Models != nil {
+		for _, m := range *modelList.Models {
+			model, diags := models.NewInferenceModelModel(ctx, &m)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			data.Models = append(data.Models, *model)
+		}
+	}
+
+	// Save data into Terraform state
+	data.Id = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
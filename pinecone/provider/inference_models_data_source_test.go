@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInferenceModelsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccInferenceModelsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pinecone_inference_models.test", "id"),
+					resource.TestCheckResourceAttrSet("data.pinecone_inference_models.test", "models.0.name"),
+					resource.TestCheckResourceAttr("data.pinecone_inference_models.test", "models.0.type", "embed"),
+				),
+			},
+		},
+	})
+}
+
+const testAccInferenceModelsDataSourceConfig = `
+provider "pinecone" {
+}
+
+data "pinecone_inference_models" "test" {
+	type = "embed"
+}
+`
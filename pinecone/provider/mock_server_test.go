@@ -0,0 +1,1072 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// mockProjectId is the project_id the provider is configured with against the mock server,
+// the project its API key belongs to.
+const mockProjectId = "mock-project-id"
+
+// mockPineconeServer is an in-memory fake of the Pinecone control plane and admin API. It
+// serves indexes, collections, projects and API keys, so that the resource lifecycles can be
+// tested offline with resource.UnitTest.
+//
+// Indexes and collections are created Initializing and become Ready once they have been
+// described readyAfter times. Errors can be injected with FailNext.
+type mockPineconeServer struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	readyAfter int
+
+	// Indexes and collections are scoped to the project of the request, "" being the
+	// project of the API key.
+	indexes     map[string]map[string]*mockObject
+	collections map[string]map[string]*mockObject
+	projects    map[string]map[string]any
+	apiKeys     map[string]map[string]any
+
+	// Projects with a private endpoint, whose indexes have a private_host.
+	privateEndpoints map[string]bool
+
+	failures []*mockFailure
+
+	// Number of requests served, keyed by method and path.
+	requests map[string]int
+}
+
+// mockObject is an index or collection along with the number of describes left before it is Ready.
+type mockObject struct {
+	body    map[string]any
+	pending int
+}
+
+// mockFailure is an error injected for the next count requests matching method and path.
+type mockFailure struct {
+	method string
+	path   string
+	status int
+	count  int
+}
+
+// newMockPineconeServer starts a mock server that is closed when the test ends.
+func newMockPineconeServer(t *testing.T) *mockPineconeServer {
+	t.Helper()
+
+	s := &mockPineconeServer{
+		readyAfter:  1,
+		indexes:     map[string]map[string]*mockObject{},
+		collections: map[string]map[string]*mockObject{},
+		projects:    map[string]map[string]any{},
+		apiKeys:     map[string]map[string]any{},
+
+		privateEndpoints: map[string]bool{},
+		requests:         map[string]int{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// FailNext makes the next count requests matching method and path fail with the given
+// HTTP status, e.g. 404, 429 or 500.
+func (s *mockPineconeServer) FailNext(method string, path string, status int, count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, &mockFailure{method: method, path: path, status: status, count: count})
+}
+
+// ClearFailures drops the errors injected with FailNext that have not been served yet.
+func (s *mockPineconeServer) ClearFailures() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = nil
+}
+
+// Requests returns the number of requests served for method and path.
+func (s *mockPineconeServer) Requests(method string, path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[method+" "+path]
+}
+
+// SetReadyAfter sets how many times the indexes and collections created from now on are
+// described before they become Ready.
+func (s *mockPineconeServer) SetReadyAfter(count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.readyAfter = count
+}
+
+// EnablePrivateEndpoint configures a private endpoint for the project, so that the indexes
+// created in it from now on have a private_host.
+func (s *mockPineconeServer) EnablePrivateEndpoint(project string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.privateEndpoints[project] = true
+}
+
+// AddIndex creates a Ready serverless index directly on the server, as if it had been
+// created outside of Terraform.
+func (s *mockPineconeServer) AddIndex(project string, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.indexes[project] == nil {
+		s.indexes[project] = map[string]*mockObject{}
+	}
+	s.indexes[project][name] = &mockObject{body: map[string]any{
+		"name":                name,
+		"dimension":           8,
+		"metric":              "cosine",
+		"vector_type":         "dense",
+		"deletion_protection": "enabled",
+		"host":                fmt.Sprintf("%s-mock.svc.pinecone.io", name),
+		"spec":                map[string]any{"serverless": map[string]any{"cloud": "aws", "region": "us-east-1"}},
+		"status":              map[string]any{"ready": true, "state": "Ready"},
+	}}
+	if s.privateEndpoints[project] {
+		s.indexes[project][name].body["private_host"] = fmt.Sprintf("%s-mock.svc.private.pinecone.io", name)
+	}
+}
+
+// TagIndex sets the tags of an index directly on the server, as if they had been set outside
+// of Terraform.
+func (s *mockPineconeServer) TagIndex(project string, name string, tags map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.indexes[project][name].body["tags"] = tags
+}
+
+// AddPodIndex creates a Ready pod-based index directly on the server, as if it had been
+// created outside of Terraform.
+func (s *mockPineconeServer) AddPodIndex(project string, name string, shards int, replicas int) {
+	s.AddIndex(project, name)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.indexes[project][name].body["deletion_protection"] = "disabled"
+	s.indexes[project][name].body["spec"] = map[string]any{"pod": map[string]any{
+		"environment": "us-west4-gcp",
+		"pod_type":    "s1.x1",
+		"pods":        indexPods(int64(shards), int64(replicas)),
+		"replicas":    replicas,
+		"shards":      shards,
+	}}
+}
+
+// AddIndexFromCollection creates a Ready pod-based index restored from the given collection
+// directly on the server, as if it had been created outside of Terraform.
+func (s *mockPineconeServer) AddIndexFromCollection(project string, name string, collection string) {
+	s.AddPodIndex(project, name, 1, 1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pod := s.indexes[project][name].body["spec"].(map[string]any)["pod"].(map[string]any)
+	pod["source_collection"] = collection
+}
+
+// RemoveIndex deletes an index directly on the server, as if it had been deleted outside of Terraform.
+func (s *mockPineconeServer) RemoveIndex(project string, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.indexes[project], name)
+}
+
+// RemoveCollection deletes a collection directly on the server, as if it had been deleted
+// outside of Terraform.
+func (s *mockPineconeServer) RemoveCollection(project string, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.collections[project], name)
+}
+
+// AddCollection creates a Ready collection directly on the server, as if it had been
+// created outside of Terraform.
+func (s *mockPineconeServer) AddCollection(project string, name string, dimension int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.collections[project] == nil {
+		s.collections[project] = map[string]*mockObject{}
+	}
+	s.collections[project][name] = &mockObject{body: map[string]any{
+		"name":         name,
+		"dimension":    dimension,
+		"environment":  "us-east1-gcp",
+		"size":         1024,
+		"vector_count": 10,
+		"status":       "Ready",
+	}}
+}
+
+// AddProject creates a project directly on the server and returns its ID.
+func (s *mockPineconeServer) AddProject(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := mockUUID()
+	s.projects[id] = map[string]any{
+		"id":                         id,
+		"name":                       name,
+		"max_pods":                   0,
+		"force_encryption_with_cmek": false,
+		"organization_id":            "mock-organization",
+		"created_at":                 time.Now().UTC().Format(time.RFC3339),
+	}
+	return id
+}
+
+// AddApiKey creates an API key directly on the server and returns its ID.
+func (s *mockPineconeServer) AddApiKey(projectId string, name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := mockUUID()
+	s.apiKeys[id] = map[string]any{
+		"id":         id,
+		"name":       name,
+		"project_id": projectId,
+		"roles":      []any{"ProjectEditor"},
+	}
+	return id
+}
+
+// CheckDestroy verifies that nothing is left on the mock server once the test is destroyed.
+func (s *mockPineconeServer) CheckDestroy(_ *terraform.State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for project, indexes := range s.indexes {
+		for name := range indexes {
+			return fmt.Errorf("index %q still exists in project %q", name, project)
+		}
+	}
+	for project, collections := range s.collections {
+		for name := range collections {
+			return fmt.Errorf("collection %q still exists in project %q", name, project)
+		}
+	}
+	for id := range s.projects {
+		return fmt.Errorf("project %q still exists", id)
+	}
+	for id := range s.apiKeys {
+		return fmt.Errorf("API key %q still exists", id)
+	}
+	return nil
+}
+
+// ProviderFactories returns provider factories whose OAuth endpoint is the mock server.
+func (s *mockPineconeServer) ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"pinecone": providerserver.NewProtocol6WithError(&PineconeProvider{
+			version:   "test",
+			transport: newMockLoginTransport(s.URL),
+		}),
+	}
+}
+
+// mockLoginTransport sends the requests for the Pinecone login endpoint to a test server,
+// since the token exchange does not follow the provider's host.
+type mockLoginTransport struct {
+	target *url.URL
+}
+
+func newMockLoginTransport(target string) *mockLoginTransport {
+	u, err := url.Parse(target)
+	if err != nil {
+		panic(err)
+	}
+	return &mockLoginTransport{target: u}
+}
+
+func (t *mockLoginTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host == "login.pinecone.io" {
+		req = req.Clone(req.Context())
+		req.URL.Scheme = t.target.Scheme
+		req.URL.Host = t.target.Host
+		req.Host = ""
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// ProviderConfig returns a provider block pointing at the mock server, with both
+// an API key and admin credentials, and the API key's project_id.
+func (s *mockPineconeServer) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "pinecone" {
+  host          = %q
+  api_key       = "mock-api-key"
+  client_id     = "mock-client-id"
+  client_secret = "mock-client-secret"
+  project_id    = %q
+}
+`, s.URL, mockProjectId)
+}
+
+// mockListResult is a result of a list resource, with its identity and resource decoded.
+type mockListResult struct {
+	DisplayName string
+	Identity    map[string]tftypes.Value
+	Resource    map[string]tftypes.Value
+	Diagnostics []*tfprotov6.Diagnostic
+}
+
+// mockProviderServer is a provider server configured against the mock server, along with
+// its schemas.
+type mockProviderServer struct {
+	tfprotov6.ProviderServer
+	schemas         *tfprotov6.GetProviderSchemaResponse
+	identitySchemas *tfprotov6.GetResourceIdentitySchemasResponse
+}
+
+// ConfiguredServer returns a provider server configured against the mock server, with both
+// an API key and admin credentials. It drives the provider RPCs directly, for features
+// that the Terraform CLI available to the tests does not support.
+func (s *mockPineconeServer) ConfiguredServer(t *testing.T) *mockProviderServer {
+	t.Helper()
+	ctx := context.Background()
+
+	server, err := s.ProviderFactories()["pinecone"]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	identitySchemas, err := server.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	providerConfig := mockDynamicValue(t, schemas.Provider, map[string]tftypes.Value{
+		"host":          tftypes.NewValue(tftypes.String, s.URL),
+		"api_key":       tftypes.NewValue(tftypes.String, "mock-api-key"),
+		"client_id":     tftypes.NewValue(tftypes.String, "mock-client-id"),
+		"client_secret": tftypes.NewValue(tftypes.String, "mock-client-secret"),
+		"project_id":    tftypes.NewValue(tftypes.String, mockProjectId),
+	})
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: providerConfig})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range configureResp.Diagnostics {
+		t.Fatalf("configuring the provider: %s: %s", d.Summary, d.Detail)
+	}
+
+	return &mockProviderServer{ProviderServer: server, schemas: schemas, identitySchemas: identitySchemas}
+}
+
+// List lists typeName with the given list configuration, as `terraform query` does.
+// Configuration attributes that are not given are null. Querying needs Terraform 1.14.
+func (s *mockPineconeServer) List(t *testing.T, typeName string, config map[string]tftypes.Value, includeResource bool, limit int64) []mockListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	server := s.ConfiguredServer(t)
+	listServer, ok := server.ProviderServer.(tfprotov6.ProviderServerWithListResource)
+	if !ok {
+		t.Fatal("provider server does not support list resources")
+	}
+
+	listSchema, ok := server.schemas.ListResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("no list resource %s", typeName)
+	}
+	stream, err := listServer.ListResource(ctx, &tfprotov6.ListResourceRequest{
+		TypeName:        typeName,
+		Config:          mockDynamicValue(t, listSchema, config),
+		IncludeResource: includeResource,
+		Limit:           limit,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var results []mockListResult
+	for result := range stream.Results {
+		decoded := mockListResult{DisplayName: result.DisplayName, Diagnostics: result.Diagnostics}
+		if result.Identity != nil {
+			decoded.Identity = mockDecode(t, result.Identity.IdentityData, server.identitySchemas.IdentitySchemas[typeName].ValueType())
+		}
+		if result.Resource != nil {
+			decoded.Resource = mockDecode(t, result.Resource, server.schemas.ResourceSchemas[typeName].ValueType())
+		}
+		results = append(results, decoded)
+	}
+	return results
+}
+
+// ImportByIdentity imports typeName with the given identity and refreshes it, as an import
+// block with an identity does. Identity attributes that are not given are null. It returns the refreshed state and identity, or the
+// diagnostics of the failed step. Importing by identity needs Terraform 1.12.
+func (s *mockPineconeServer) ImportByIdentity(t *testing.T, typeName string, identity map[string]tftypes.Value) (map[string]tftypes.Value, map[string]tftypes.Value, []*tfprotov6.Diagnostic) {
+	t.Helper()
+	ctx := context.Background()
+
+	server := s.ConfiguredServer(t)
+	identitySchema := server.identitySchemas.IdentitySchemas[typeName]
+	identityType := identitySchema.ValueType()
+	values := map[string]tftypes.Value{}
+	for _, attribute := range identitySchema.IdentityAttributes {
+		values[attribute.Name] = tftypes.NewValue(attribute.Type, nil)
+	}
+	for name, value := range identity {
+		values[name] = value
+	}
+	identityValue, err := tfprotov6.NewDynamicValue(identityType, tftypes.NewValue(identityType, values))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	importResp, err := server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{
+		TypeName: typeName,
+		Identity: &tfprotov6.ResourceIdentityData{IdentityData: &identityValue},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(importResp.Diagnostics) > 0 {
+		return nil, nil, importResp.Diagnostics
+	}
+	if len(importResp.ImportedResources) != 1 {
+		t.Fatalf("expected 1 imported resource, got %d", len(importResp.ImportedResources))
+	}
+	imported := importResp.ImportedResources[0]
+
+	readResp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:        typeName,
+		CurrentState:    imported.State,
+		CurrentIdentity: imported.Identity,
+		Private:         imported.Private,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(readResp.Diagnostics) > 0 {
+		return nil, nil, readResp.Diagnostics
+	}
+
+	state := mockDecode(t, readResp.NewState, server.schemas.ResourceSchemas[typeName].ValueType())
+	return state, mockDecode(t, readResp.NewIdentity.IdentityData, identityType), nil
+}
+
+// PlanUpdate plans the update of typeName from the prior state to the configuration, as
+// terraform plan does, and returns the diagnostics of the plan. The new state proposed to the
+// provider is the prior state with the configured attributes replaced.
+func (s *mockPineconeServer) PlanUpdate(t *testing.T, typeName string, prior map[string]tftypes.Value, config map[string]tftypes.Value) []*tfprotov6.Diagnostic {
+	t.Helper()
+
+	server := s.ConfiguredServer(t)
+	schema := server.schemas.ResourceSchemas[typeName]
+
+	proposed := map[string]tftypes.Value{}
+	for name, value := range prior {
+		proposed[name] = value
+	}
+	for name, value := range config {
+		proposed[name] = value
+	}
+
+	planResp, err := server.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       mockDynamicValue(t, schema, prior),
+		ProposedNewState: mockDynamicValue(t, schema, proposed),
+		Config:           mockDynamicValue(t, schema, config),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return planResp.Diagnostics
+}
+
+// mockDynamicValue encodes the attributes as a value of the schema, the missing ones and the
+// blocks being null.
+func mockDynamicValue(t *testing.T, schema *tfprotov6.Schema, attributes map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	values := map[string]tftypes.Value{}
+	for _, attribute := range schema.Block.Attributes {
+		values[attribute.Name] = tftypes.NewValue(attribute.ValueType(), nil)
+	}
+	for _, block := range schema.Block.BlockTypes {
+		values[block.TypeName] = tftypes.NewValue(block.ValueType(), nil)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+
+	value, err := tfprotov6.NewDynamicValue(schema.ValueType(), tftypes.NewValue(schema.ValueType(), values))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &value
+}
+
+// mockDecode decodes an object value into its attributes.
+func mockDecode(t *testing.T, value *tfprotov6.DynamicValue, typ tftypes.Type) map[string]tftypes.Value {
+	t.Helper()
+
+	object, err := value.Unmarshal(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attributes := map[string]tftypes.Value{}
+	if err := object.As(&attributes); err != nil {
+		t.Fatal(err)
+	}
+	return attributes
+}
+
+// testMockPreCheck skips tests against the mock server when no Terraform CLI is available,
+// rather than letting the test framework download one.
+func testMockPreCheck(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("terraform CLI not found in PATH, skipping mock server test")
+	}
+}
+
+func (s *mockPineconeServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests[r.Method+" "+r.URL.Path]++
+	for _, f := range s.failures {
+		if f.count > 0 && f.method == r.Method && f.path == r.URL.Path {
+			f.count--
+			writeMockError(w, f.status, "Injected error: "+strings.ToLower(http.StatusText(f.status)))
+			return
+		}
+	}
+
+	var body map[string]any
+	if r.Body != nil && r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeMockError(w, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+			return
+		}
+	}
+
+	project := r.Header.Get("X-Project-Id")
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case r.URL.Path == "/oauth/token" && r.Method == http.MethodPost:
+		writeMockJSON(w, http.StatusOK, map[string]any{"access_token": "mock-access-token", "token_type": "Bearer"})
+	case segments[0] == "indexes":
+		s.serveIndexes(w, r.Method, project, segments[1:], body)
+	case segments[0] == "collections":
+		s.serveCollections(w, r.Method, project, segments[1:], body)
+	case segments[0] == "admin" && len(segments) > 1 && segments[1] == "projects":
+		s.serveProjects(w, r.Method, segments[2:], body)
+	case segments[0] == "admin" && len(segments) == 3 && segments[1] == "api-keys":
+		s.serveApiKey(w, r.Method, segments[2], body)
+	case segments[0] == "models" && len(segments) == 2 && r.Method == http.MethodGet:
+		if model, ok := mockModels[segments[1]]; ok {
+			writeMockJSON(w, http.StatusOK, model)
+		} else {
+			writeMockError(w, http.StatusNotFound, fmt.Sprintf("Model %s not found", segments[1]))
+		}
+	case r.URL.Path == "/assistant/assistants" && r.Method == http.MethodGet:
+		writeMockJSON(w, http.StatusOK, map[string]any{"assistants": []any{}})
+	default:
+		writeMockError(w, http.StatusNotFound, fmt.Sprintf("Route %s %s not found", r.Method, r.URL.Path))
+	}
+}
+
+func (s *mockPineconeServer) serveIndexes(w http.ResponseWriter, method string, project string, segments []string, body map[string]any) {
+	if s.indexes[project] == nil {
+		s.indexes[project] = map[string]*mockObject{}
+	}
+	indexes := s.indexes[project]
+
+	if len(segments) == 0 {
+		switch method {
+		case http.MethodGet:
+			writeMockJSON(w, http.StatusOK, map[string]any{"indexes": s.describeAll(indexes)})
+		case http.MethodPost:
+			s.createIndex(w, project, indexes, body)
+		default:
+			writeMockError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	if segments[0] == "create-for-model" && method == http.MethodPost {
+		s.createIndexForModel(w, project, indexes, body)
+		return
+	}
+
+	name := segments[0]
+	index, ok := indexes[name]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, fmt.Sprintf("Resource %s not found", name))
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeMockJSON(w, http.StatusOK, s.describe(index))
+	case http.MethodPatch:
+		configureMockIndex(index.body, body)
+		writeMockJSON(w, http.StatusOK, index.body)
+	case http.MethodDelete:
+		if index.body["deletion_protection"] == "enabled" {
+			writeMockError(w, http.StatusForbidden, fmt.Sprintf("Deletion protection is enabled for index %s", name))
+			return
+		}
+		delete(indexes, name)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		writeMockError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *mockPineconeServer) createIndex(w http.ResponseWriter, project string, indexes map[string]*mockObject, body map[string]any) {
+	name, _ := body["name"].(string)
+	if name == "" {
+		writeMockError(w, http.StatusBadRequest, "Index name is required")
+		return
+	}
+	if _, ok := indexes[name]; ok {
+		writeMockError(w, http.StatusConflict, fmt.Sprintf("Resource %s already exists", name))
+		return
+	}
+
+	index := map[string]any{
+		"name":                name,
+		"metric":              "cosine",
+		"vector_type":         "dense",
+		"deletion_protection": "disabled",
+		"host":                fmt.Sprintf("%s-mock.svc.pinecone.io", name),
+		"status":              map[string]any{"ready": false, "state": "Initializing"},
+	}
+	if s.privateEndpoints[project] {
+		index["private_host"] = fmt.Sprintf("%s-mock.svc.private.pinecone.io", name)
+	}
+	for _, key := range []string{"dimension", "metric", "vector_type", "deletion_protection", "tags", "spec", "embed"} {
+		if v, ok := body[key]; ok && v != nil {
+			index[key] = v
+		}
+	}
+	if spec, ok := index["spec"].(map[string]any); ok {
+		if pod, ok := spec["pod"].(map[string]any); ok {
+			replicas := mockNumber(pod, "replicas", 1)
+			shards := mockNumber(pod, "shards", 1)
+			pod["replicas"] = replicas
+			pod["shards"] = shards
+			pod["pods"] = indexPods(int64(shards), int64(replicas))
+		}
+	}
+
+	indexes[name] = &mockObject{body: index, pending: s.readyAfter}
+	writeMockJSON(w, http.StatusCreated, index)
+}
+
+// createIndexForModel creates an integrated serverless index, taking the vector type and the
+// default dimension from the model catalog the way the API does.
+func (s *mockPineconeServer) createIndexForModel(w http.ResponseWriter, project string, indexes map[string]*mockObject, body map[string]any) {
+	embed, _ := body["embed"].(map[string]any)
+	modelName, _ := embed["model"].(string)
+	model, ok := mockModels[modelName]
+	if !ok {
+		writeMockError(w, http.StatusBadRequest, fmt.Sprintf("Model %s not found", modelName))
+		return
+	}
+
+	metric, _ := embed["metric"].(string)
+	if metric == "" {
+		metric = "cosine"
+	}
+	indexEmbed := map[string]any{
+		"model":            modelName,
+		"metric":           metric,
+		"vector_type":      model["vector_type"],
+		"field_map":        embed["field_map"],
+		"read_parameters":  map[string]any{"input_type": "query", "truncate": "END"},
+		"write_parameters": map[string]any{"input_type": "passage", "truncate": "END"},
+	}
+	for _, key := range []string{"read_parameters", "write_parameters"} {
+		if v, ok := embed[key]; ok && v != nil {
+			indexEmbed[key] = v
+		}
+	}
+	index := map[string]any{
+		"name":                body["name"],
+		"metric":              metric,
+		"vector_type":         model["vector_type"],
+		"deletion_protection": body["deletion_protection"],
+		"tags":                body["tags"],
+		"spec":                map[string]any{"serverless": map[string]any{"cloud": body["cloud"], "region": body["region"]}},
+		"embed":               indexEmbed,
+	}
+	if model["vector_type"] == "dense" {
+		dimension := embed["dimension"]
+		if dimension == nil {
+			dimension = model["default_dimension"]
+		}
+		index["dimension"] = dimension
+		indexEmbed["dimension"] = dimension
+	}
+	s.createIndex(w, project, indexes, index)
+}
+
+// configureMockIndex applies a configure request to an index the way the API does: tags are
+// merged, with an empty value removing the tag, and the spec is patched field by field.
+func configureMockIndex(index map[string]any, body map[string]any) {
+	if v, ok := body["deletion_protection"]; ok && v != nil {
+		index["deletion_protection"] = v
+	}
+	if tags, ok := body["tags"].(map[string]any); ok {
+		merged, _ := index["tags"].(map[string]any)
+		if merged == nil {
+			merged = map[string]any{}
+		}
+		for k, v := range tags {
+			if v == "" {
+				delete(merged, k)
+			} else {
+				merged[k] = v
+			}
+		}
+		index["tags"] = merged
+	}
+	if spec, ok := body["spec"].(map[string]any); ok {
+		current, _ := index["spec"].(map[string]any)
+		for kind, patch := range spec {
+			target, _ := current[kind].(map[string]any)
+			if target == nil {
+				continue
+			}
+			for k, v := range patch.(map[string]any) {
+				target[k] = v
+			}
+			if kind == "pod" {
+				target["pods"] = indexPods(int64(mockNumber(target, "shards", 1)), int64(mockNumber(target, "replicas", 1)))
+			}
+		}
+	}
+	if embed, ok := body["embed"].(map[string]any); ok {
+		current, _ := index["embed"].(map[string]any)
+		if current == nil {
+			current = map[string]any{}
+		}
+		for k, v := range embed {
+			current[k] = v
+		}
+		index["embed"] = current
+	}
+}
+
+func (s *mockPineconeServer) serveCollections(w http.ResponseWriter, method string, project string, segments []string, body map[string]any) {
+	if s.collections[project] == nil {
+		s.collections[project] = map[string]*mockObject{}
+	}
+	collections := s.collections[project]
+
+	if len(segments) == 0 {
+		switch method {
+		case http.MethodGet:
+			writeMockJSON(w, http.StatusOK, map[string]any{"collections": s.describeAll(collections)})
+		case http.MethodPost:
+			s.createCollection(w, project, collections, body)
+		default:
+			writeMockError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	name := segments[0]
+	collection, ok := collections[name]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, fmt.Sprintf("Resource %s not found", name))
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeMockJSON(w, http.StatusOK, s.describe(collection))
+	case http.MethodDelete:
+		delete(collections, name)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		writeMockError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *mockPineconeServer) createCollection(w http.ResponseWriter, project string, collections map[string]*mockObject, body map[string]any) {
+	name, _ := body["name"].(string)
+	source, _ := body["source"].(string)
+	if _, ok := collections[name]; ok {
+		writeMockError(w, http.StatusConflict, fmt.Sprintf("Resource %s already exists", name))
+		return
+	}
+	index, ok := s.indexes[project][source]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, fmt.Sprintf("Resource %s not found", source))
+		return
+	}
+	pod, _ := index.body["spec"].(map[string]any)["pod"].(map[string]any)
+	if pod == nil {
+		writeMockError(w, http.StatusBadRequest, "Collections can only be created from pod-based indexes")
+		return
+	}
+
+	collection := map[string]any{
+		"name":         name,
+		"dimension":    index.body["dimension"],
+		"environment":  pod["environment"],
+		"size":         0,
+		"vector_count": 0,
+		"status":       "Initializing",
+	}
+	collections[name] = &mockObject{body: collection, pending: s.readyAfter}
+	writeMockJSON(w, http.StatusCreated, collection)
+}
+
+// describe returns the object, moving it to Ready once it has been described readyAfter times.
+func (s *mockPineconeServer) describe(object *mockObject) map[string]any {
+	if object.pending > 0 {
+		object.pending--
+		if object.pending == 0 {
+			if _, ok := object.body["status"].(map[string]any); ok {
+				object.body["status"] = map[string]any{"ready": true, "state": "Ready"}
+			} else {
+				object.body["status"] = "Ready"
+			}
+		}
+	}
+	return object.body
+}
+
+func (s *mockPineconeServer) describeAll(objects map[string]*mockObject) []any {
+	names := make([]string, 0, len(objects))
+	for name := range objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]any, 0, len(names))
+	for _, name := range names {
+		result = append(result, objects[name].body)
+	}
+	return result
+}
+
+func (s *mockPineconeServer) serveProjects(w http.ResponseWriter, method string, segments []string, body map[string]any) {
+	if len(segments) == 0 {
+		switch method {
+		case http.MethodGet:
+			projects := make([]any, 0, len(s.projects))
+			for _, project := range s.projects {
+				projects = append(projects, project)
+			}
+			writeMockJSON(w, http.StatusOK, map[string]any{"data": projects})
+		case http.MethodPost:
+			project := map[string]any{
+				"id":                         mockUUID(),
+				"name":                       body["name"],
+				"max_pods":                   0,
+				"force_encryption_with_cmek": false,
+				"organization_id":            "mock-organization",
+				"created_at":                 time.Now().UTC().Format(time.RFC3339),
+			}
+			patchMockObject(project, body, "max_pods", "force_encryption_with_cmek")
+			s.projects[project["id"].(string)] = project
+			writeMockJSON(w, http.StatusOK, project)
+		default:
+			writeMockError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	id := segments[0]
+	project, ok := s.projects[id]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, fmt.Sprintf("Project %s not found", id))
+		return
+	}
+
+	if len(segments) == 2 && segments[1] == "api-keys" {
+		s.serveProjectApiKeys(w, method, id, body)
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeMockJSON(w, http.StatusOK, project)
+	case http.MethodPatch:
+		patchMockObject(project, body, "name", "max_pods", "force_encryption_with_cmek")
+		writeMockJSON(w, http.StatusOK, project)
+	case http.MethodDelete:
+		if len(s.indexes[id]) > 0 || len(s.collections[id]) > 0 {
+			writeMockError(w, http.StatusPreconditionFailed, fmt.Sprintf("Project %s is not empty", id))
+			return
+		}
+		for keyId, key := range s.apiKeys {
+			if key["project_id"] == id {
+				delete(s.apiKeys, keyId)
+			}
+		}
+		delete(s.projects, id)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		writeMockError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *mockPineconeServer) serveProjectApiKeys(w http.ResponseWriter, method string, projectId string, body map[string]any) {
+	switch method {
+	case http.MethodGet:
+		keys := []any{}
+		for _, key := range s.apiKeys {
+			if key["project_id"] == projectId {
+				keys = append(keys, key)
+			}
+		}
+		writeMockJSON(w, http.StatusOK, map[string]any{"data": keys})
+	case http.MethodPost:
+		key := map[string]any{
+			"id":         mockUUID(),
+			"name":       body["name"],
+			"project_id": projectId,
+			"roles":      []any{"ProjectEditor"},
+		}
+		patchMockObject(key, body, "roles")
+		s.apiKeys[key["id"].(string)] = key
+		writeMockJSON(w, http.StatusOK, map[string]any{"key": key, "value": "pcsk_" + key["id"].(string)})
+	default:
+		writeMockError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *mockPineconeServer) serveApiKey(w http.ResponseWriter, method string, id string, body map[string]any) {
+	key, ok := s.apiKeys[id]
+	if !ok {
+		writeMockError(w, http.StatusNotFound, fmt.Sprintf("API key %s not found", id))
+		return
+	}
+
+	switch method {
+	case http.MethodGet:
+		writeMockJSON(w, http.StatusOK, key)
+	case http.MethodPatch:
+		patchMockObject(key, body, "name", "roles")
+		writeMockJSON(w, http.StatusOK, key)
+	case http.MethodDelete:
+		delete(s.apiKeys, id)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		writeMockError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// patchMockObject copies the given keys from body to object when they are set.
+func patchMockObject(object map[string]any, body map[string]any, keys ...string) {
+	for _, key := range keys {
+		if v, ok := body[key]; ok && v != nil {
+			object[key] = v
+		}
+	}
+}
+
+// mockNumber reads a JSON number from m, falling back to def when it is unset.
+func mockNumber(m map[string]any, key string, def int) int {
+	if v, ok := m[key].(float64); ok && v > 0 {
+		return int(v)
+	}
+	if v, ok := m[key].(int); ok && v > 0 {
+		return v
+	}
+	return def
+}
+
+func mockUUID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// mockModels is the inference model catalog served by the mock server.
+var mockModels = map[string]map[string]any{
+	"multilingual-e5-large": {
+		"model":                "multilingual-e5-large",
+		"short_description":    "A multilingual dense embedding model.",
+		"type":                 "embed",
+		"vector_type":          "dense",
+		"modality":             "text",
+		"default_dimension":    1024,
+		"supported_dimensions": []any{1024},
+		"supported_metrics":    []any{"cosine", "euclidean", "dotproduct"},
+		"supported_parameters": []any{},
+	},
+	"pinecone-sparse-english-v0": {
+		"model":                "pinecone-sparse-english-v0",
+		"short_description":    "A sparse embedding model for English.",
+		"type":                 "embed",
+		"vector_type":          "sparse",
+		"modality":             "text",
+		"supported_metrics":    []any{"dotproduct"},
+		"supported_parameters": []any{},
+	},
+}
+
+func writeMockJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeMockError writes an error in the format of the Pinecone API.
+func writeMockError(w http.ResponseWriter, status int, message string) {
+	code := "UNKNOWN"
+	switch status {
+	case http.StatusBadRequest:
+		code = "INVALID_ARGUMENT"
+	case http.StatusForbidden:
+		code = "FORBIDDEN"
+	case http.StatusNotFound:
+		code = "NOT_FOUND"
+	case http.StatusConflict:
+		code = "ALREADY_EXISTS"
+	case http.StatusPreconditionFailed:
+		code = "FAILED_PRECONDITION"
+	case http.StatusTooManyRequests:
+		code = "RESOURCE_EXHAUSTED"
+	}
+	writeMockJSON(w, status, map[string]any{
+		"error":  map[string]any{"code": code, "message": message},
+		"status": status,
+	})
+}
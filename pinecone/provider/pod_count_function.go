@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// podTypePattern matches pod types such as "p1.x2".
+var podTypePattern = regexp.MustCompile(`^(?:s1|p1|p2)\.x(?:1|2|4|8)$`)
+
+// podCount returns the number of pods used by an index of the given pod type, shards and
+// replicas. The pod type is only validated: like the pod count reported by the API, the
+// count does not depend on the pod size.
+func podCount(podType string, shards int64, replicas int64) (int64, error) {
+	if !podTypePattern.MatchString(podType) {
+		return 0, fmt.Errorf("invalid pod type %q, expected one of s1, p1 or p2 followed by a size of x1, x2, x4 or x8, e.g. \"p1.x2\"", podType)
+	}
+
+	return indexPods(shards, replicas), nil
+}
+
+// indexPods returns the number of pods of a pod-based index with the given shards and
+// replicas, the way the API computes the pod count of an index.
+func indexPods(shards int64, replicas int64) int64 {
+	return shards * replicas
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &PodCountFunction{}
+
+func NewPodCountFunction() function.Function {
+	return &PodCountFunction{}
+}
+
+// PodCountFunction defines the function implementation.
+type PodCountFunction struct{}
+
+func (f *PodCountFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "pod_count"
+}
+
+func (f *PodCountFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compute the number of pods used by a pod-based index",
+		MarkdownDescription: "Computes the number of pods used by a pod-based index, as counted against the `max_pods` quota of a project: " +
+			"shards × replicas, the same count the API reports for the index. The pod type is validated but, as in the API, the pod size does not change the count.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pod_type",
+				MarkdownDescription: "The pod type, e.g. `p1.x2`.",
+			},
+			function.Int64Parameter{
+				Name:                "shards",
+				MarkdownDescription: "The number of shards.",
+				Validators: []function.Int64ParameterValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			function.Int64Parameter{
+				Name:                "replicas",
+				MarkdownDescription: "The number of replicas.",
+				Validators: []function.Int64ParameterValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *PodCountFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var podType string
+	var shards, replicas int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &podType, &shards, &replicas))
+	if resp.Error != nil {
+		return
+	}
+
+	count, err := podCount(podType, shards, replicas)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, count))
+}
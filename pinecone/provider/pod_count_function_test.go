@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestPodCount(t *testing.T) {
+	tests := []struct {
+		podType  string
+		shards   int64
+		replicas int64
+		want     int64
+		wantErr  bool
+	}{
+		{podType: "s1.x1", shards: 1, replicas: 1, want: 1},
+		{podType: "p1.x2", shards: 1, replicas: 1, want: 1},
+		{podType: "p2.x4", shards: 2, replicas: 3, want: 6},
+		{podType: "p1.x8", shards: 1, replicas: 2, want: 2},
+		{podType: "p1.x3", shards: 1, replicas: 1, wantErr: true},
+		{podType: "p3.x1", shards: 1, replicas: 1, wantErr: true},
+		{podType: "P1.X1", shards: 1, replicas: 1, wantErr: true},
+		{podType: "", shards: 1, replicas: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := podCount(tt.podType, tt.shards, tt.replicas)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("podCount(%q, %d, %d) error = %v, wantErr %t", tt.podType, tt.shards, tt.replicas, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("podCount(%q, %d, %d) = %d, want %d", tt.podType, tt.shards, tt.replicas, got, tt.want)
+		}
+	}
+}
+
+func TestPodCountFunction(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::pinecone::pod_count("p1.x2", 2, 3)
+}
+`,
+				Check: resource.TestCheckOutput("test", "6"),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::pinecone::pod_count("p1.x3", 1, 1)
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid pod type`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::pinecone::pod_count("p1.x1", 0, 1)
+}
+`,
+				ExpectError: regexp.MustCompile(`at least 1`),
+			},
+		},
+	})
+}
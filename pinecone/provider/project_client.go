@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+const (
+	// pineconeAuthURL is the OAuth endpoint used to exchange admin credentials for an access token.
+	pineconeAuthURL = "https://login.pinecone.io/oauth/token"
+
+	// pineconeControllerURL is the default base URL of the Pinecone control plane.
+	pineconeControllerURL = "https://api.pinecone.io"
+
+	// httpTimeout bounds each request the provider sends itself, token requests included.
+	httpTimeout = 60 * time.Second
+
+	// tokenRefreshMargin is how long before its expiry an access token is renewed, so that
+	// a request sent just before the expiry is not rejected.
+	tokenRefreshMargin = 5 * time.Minute
+)
+
+// assistantApiVersion is the Assistant API version used for the assistant requests made by the provider.
+const assistantApiVersion = "2025-10"
+
+// accessToken returns an OAuth access token for the configured admin credentials.
+// The token is shared by every resource of the provider instance, and renewed shortly
+// before it expires, so that long applies keep working.
+func (p *PineconeProviderData) accessToken(ctx context.Context) (string, error) {
+	p.tokenMu.Lock()
+	defer p.tokenMu.Unlock()
+
+	if p.token != "" && (p.tokenExpiry.IsZero() || time.Now().Before(p.tokenExpiry.Add(-tokenRefreshMargin))) {
+		return p.token, nil
+	}
+	if p.clientId == "" || p.clientSecret == "" {
+		return "", fmt.Errorf("admin client credentials (client_id and client_secret) are required")
+	}
+
+	// The token is for the control plane the provider talks to.
+	body, err := json.Marshal(map[string]string{
+		"client_id":     p.clientId,
+		"client_secret": p.clientSecret,
+		"grant_type":    "client_credentials",
+		"audience":      strings.TrimSuffix(p.controllerURL, "/") + "/",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, pineconeAuthURL, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	requested := time.Now()
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		resBody, _ := io.ReadAll(res.Body)
+		return "", fmt.Errorf("failed to get auth token: %s: %s", res.Status, string(resBody))
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&tokenResponse); err != nil {
+		return "", err
+	}
+
+	p.token = tokenResponse.AccessToken
+	p.tokenExpiry = time.Time{}
+	if tokenResponse.ExpiresIn > 0 {
+		p.tokenExpiry = requested.Add(time.Duration(tokenResponse.ExpiresIn) * time.Second)
+	}
+	return p.token, nil
+}
+
+// tokenTransport authorizes each request with the current access token, so that clients
+// living longer than a token, such as the one waiting for a project to empty, stay authorized.
+type tokenTransport struct {
+	providerData *PineconeProviderData
+	base         http.RoundTripper
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.providerData.accessToken(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	// A RoundTripper must not modify the request it is given.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.base.RoundTrip(req)
+}
+
+// authorizedClient returns an HTTP client authorizing its requests with the admin credentials.
+func (p *PineconeProviderData) authorizedClient() *http.Client {
+	base := p.httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &http.Client{
+		Transport: &tokenTransport{providerData: p, base: base},
+		Timeout:   p.httpClient.Timeout,
+	}
+}
+
+// NewProjectClient returns a Pinecone client scoped to the given project. It authenticates
+// with the admin credentials, so it can reach projects the provider's API key does not belong to.
+func (p *PineconeProviderData) NewProjectClient(ctx context.Context, projectId string) (*pinecone.Client, error) {
+	return pinecone.NewClientBase(pinecone.NewClientBaseParams{
+		Headers: map[string]string{
+			"X-Project-Id": projectId,
+		},
+		Host:       p.controllerURL,
+		RestClient: p.authorizedClient(),
+		SourceTag:  "terraform",
+	})
+}
+
+// doAssistantRequest sends a request to the Assistant control plane on behalf of the given project.
+func (p *PineconeProviderData) doAssistantRequest(ctx context.Context, method string, projectId string, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(p.controllerURL, "/")+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Project-Id", projectId)
+	req.Header.Set("X-Pinecone-Api-Version", assistantApiVersion)
+
+	return p.authorizedClient().Do(req)
+}
+
+// ListAssistants returns the names of the assistants in the given project.
+// The Go SDK does not cover the Assistant API, so the request is made directly.
+func (p *PineconeProviderData) ListAssistants(ctx context.Context, projectId string) ([]string, error) {
+	res, err := p.doAssistantRequest(ctx, http.MethodGet, projectId, "/assistant/assistants")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		resBody, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("failed to list assistants: %s: %s", res.Status, string(resBody))
+	}
+
+	var assistants struct {
+		Assistants []struct {
+			Name string `json:"name"`
+		} `json:"assistants"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&assistants); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(assistants.Assistants))
+	for _, a := range assistants.Assistants {
+		names = append(names, a.Name)
+	}
+	return names, nil
+}
+
+// DeleteAssistant deletes the named assistant from the given project.
+func (p *PineconeProviderData) DeleteAssistant(ctx context.Context, projectId string, name string) error {
+	res, err := p.doAssistantRequest(ctx, http.MethodDelete, projectId, "/assistant/assistants/"+url.PathEscape(name))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusAccepted &&
+		res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
+		resBody, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("failed to delete assistant %q: %s: %s", name, res.Status, string(resBody))
+	}
+	return nil
+}
+
+// projectContents lists what is still inside a project.
+type projectContents struct {
+	Indexes     []string
+	Collections []string
+	Assistants  []string
+	ApiKeys     []string
+}
+
+// IsEmpty reports whether the project holds nothing that would block its deletion.
+func (c *projectContents) IsEmpty() bool {
+	return len(c.Indexes) == 0 && len(c.Collections) == 0 && len(c.Assistants) == 0 && len(c.ApiKeys) == 0
+}
+
+// String renders the contents as a human-readable list for diagnostics.
+func (c *projectContents) String() string {
+	var buf bytes.Buffer
+	write := func(kind string, names []string) {
+		if len(names) == 0 {
+			return
+		}
+		sort.Strings(names)
+		fmt.Fprintf(&buf, "\n  - %s:", kind)
+		for _, name := range names {
+			fmt.Fprintf(&buf, "\n      %s", name)
+		}
+	}
+	write("indexes", c.Indexes)
+	write("collections", c.Collections)
+	write("assistants", c.Assistants)
+	write("API keys", c.ApiKeys)
+	return buf.String()
+}
+
+// listProjectContents collects the indexes, collections, assistants and API keys in a project.
+func (p *PineconeProviderData) listProjectContents(ctx context.Context, projectId string) (*projectContents, error) {
+	client, err := p.NewProjectClient(ctx, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	contents := &projectContents{}
+
+	indexes, err := client.ListIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexes: %w", err)
+	}
+	for _, index := range indexes {
+		contents.Indexes = append(contents.Indexes, index.Name)
+	}
+
+	collections, err := client.ListCollections(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+	for _, collection := range collections {
+		contents.Collections = append(contents.Collections, collection.Name)
+	}
+
+	contents.Assistants, err = p.ListAssistants(ctx, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := p.AdminClient.APIKey.List(ctx, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+	for _, key := range apiKeys {
+		contents.ApiKeys = append(contents.ApiKeys, fmt.Sprintf("%s (%s)", key.Name, key.Id))
+	}
+
+	return contents, nil
+}
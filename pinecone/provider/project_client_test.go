@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAccessToken_renewedBeforeExpiry(t *testing.T) {
+	var requests int
+	var expiresIn int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": fmt.Sprintf("token-%d", requests),
+			"expires_in":   expiresIn,
+		})
+	}))
+	defer server.Close()
+
+	p := &PineconeProviderData{
+		clientId:      "client-id",
+		clientSecret:  "client-secret",
+		controllerURL: pineconeControllerURL,
+		httpClient:    &http.Client{Timeout: time.Second, Transport: newMockLoginTransport(server.URL)},
+	}
+
+	// A token far from its expiry is reused.
+	expiresIn = 86400
+	for range 2 {
+		token, err := p.accessToken(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != "token-1" {
+			t.Fatalf("expected token-1, got %s", token)
+		}
+	}
+
+	// A token about to expire is renewed.
+	p.tokenExpiry = time.Now().Add(tokenRefreshMargin / 2)
+	token, err := p.accessToken(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "token-2" || requests != 2 {
+		t.Fatalf("expected a renewed token-2 after 2 requests, got %s after %d", token, requests)
+	}
+}
+
+func TestNewProjectClient_authorizesEachRequest(t *testing.T) {
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/oauth/token" {
+			_ = json.NewEncoder(w).Encode(map[string]any{"access_token": fmt.Sprintf("token-%d", len(authorizations)), "expires_in": 86400})
+			return
+		}
+		authorizations = append(authorizations, r.Header.Get("Authorization")+" "+r.Header.Get("X-Project-Id"))
+		_ = json.NewEncoder(w).Encode(map[string]any{"indexes": []any{}})
+	}))
+	defer server.Close()
+
+	p := &PineconeProviderData{
+		clientId:      "client-id",
+		clientSecret:  "client-secret",
+		controllerURL: server.URL,
+		httpClient:    &http.Client{Timeout: time.Second, Transport: newMockLoginTransport(server.URL)},
+	}
+
+	client, err := p.NewProjectClient(t.Context(), "project-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ListIndexes(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	// The token expires while the client is still in use, so the next request carries a new one.
+	p.tokenExpiry = time.Now()
+	if _, err := client.ListIndexes(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"Bearer token-0 project-1", "Bearer token-1 project-1"}
+	if fmt.Sprint(authorizations) != fmt.Sprint(expected) {
+		t.Fatalf("expected authorizations %v, got %v", expected, authorizations)
+	}
+}
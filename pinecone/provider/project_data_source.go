@@ -3,14 +3,19 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &ProjectDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ProjectDataSource{}
 
 func NewProjectDataSource() datasource.DataSource {
 	return &ProjectDataSource{PineconeDatasource: &PineconeDatasource{}}
@@ -28,15 +33,17 @@ func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.Metadat
 func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Project data source",
+		MarkdownDescription: "Project data source. Look up the project by exactly one of `id` or `name`.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Project identifier",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the project.",
+				MarkdownDescription: "The name of the project. When set, the project is looked up by its exact name, which must be unique in the organization.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"organization_id": schema.StringAttribute{
@@ -75,10 +82,19 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	project, err := d.adminClient.Project.Describe(ctx, data.Id.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe project, got error: %s", err))
-		return
+	var project *pinecone.Project
+	if !data.Id.IsNull() {
+		var err error
+		project, err = d.adminClient.Project.Describe(ctx, data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe project, got error: %s", err))
+			return
+		}
+	} else {
+		project = d.findProjectByName(ctx, data.Name.ValueString(), resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Save data into Terraform state
@@ -86,3 +102,48 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (d *ProjectDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// findProjectByName returns the project with exactly the given name. Project names are not
+// unique, so more than one match is reported as an error rather than picking one.
+func (d *ProjectDataSource) findProjectByName(ctx context.Context, name string, resp *datasource.ReadResponse) *pinecone.Project {
+	projects, err := d.adminClient.Project.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list projects, got error: %s", err))
+		return nil
+	}
+
+	var matches []*pinecone.Project
+	for _, p := range projects {
+		if p.Name == name {
+			matches = append(matches, p)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Project not found", fmt.Sprintf("No project is named %q.", name))
+		return nil
+	case 1:
+		return matches[0]
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, p := range matches {
+			ids = append(ids, p.Id)
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Multiple projects found",
+			fmt.Sprintf("%d projects are named %q (IDs: %s). Look the project up by id instead.", len(matches), name, strings.Join(ids, ", ")),
+		)
+		return nil
+	}
+}
@@ -67,6 +67,40 @@ func TestAccProjectDataSourceWithRealProject(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.pinecone_project.test", "max_pods"),
 				),
 			},
+			{
+				// Lookup by name
+				Config: fmt.Sprintf(`
+					provider "pinecone" {
+						client_id     = "%s"
+						client_secret = "%s"
+					}
+
+					data "pinecone_project" "test" {
+						id = "%s"
+					}
+
+					data "pinecone_project" "by_name" {
+						name = data.pinecone_project.test.name
+					}
+				`, clientId, clientSecret, projectID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_project.by_name", "id", projectID),
+					resource.TestCheckResourceAttrPair("data.pinecone_project.by_name", "name", "data.pinecone_project.test", "name"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					provider "pinecone" {
+						client_id     = "%s"
+						client_secret = "%s"
+					}
+
+					data "pinecone_project" "missing" {
+						name = "tftest-project-that-does-not-exist"
+					}
+				`, clientId, clientSecret),
+				ExpectError: regexp.MustCompile("Project not found"),
+			},
 		},
 	})
 }
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &ProjectListResource{}
+var _ list.ListResourceWithConfigure = &ProjectListResource{}
+
+func NewProjectListResource() list.ListResource {
+	return &ProjectListResource{ProjectResource: &ProjectResource{PineconeResource: &PineconeResource{}}}
+}
+
+// ProjectListResource lists the projects of the organization, for `terraform query`.
+type ProjectListResource struct {
+	*ProjectResource
+}
+
+func (r *ProjectListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the projects in your organization, sorted by name. The filters are the same as the ones of the `pinecone_projects` data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list projects whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Only list projects created after this RFC 3339 timestamp, e.g. `2025-01-01T00:00:00Z`.",
+				Optional:            true,
+			},
+			"created_before": schema.StringAttribute{
+				MarkdownDescription: "Only list projects created before this RFC 3339 timestamp, e.g. `2025-01-01T00:00:00Z`.",
+				Optional:            true,
+			},
+			"force_encryption_with_cmek": schema.BoolAttribute{
+				MarkdownDescription: "Only list projects that do, or do not, force encryption with a customer-managed encryption key (CMEK).",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ProjectListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data models.ProjectFilterModel
+	var diags diag.Diagnostics
+
+	// Read Terraform configuration data into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter, filterDiags := newProjectFilter(data)
+	diags.Append(filterDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// Check if admin client is available
+	if r.adminClient == nil {
+		diags.AddError("Admin client not configured", "Admin client credentials (client_id and client_secret) are required to list projects.")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	projects, err := r.adminClient.Project.List(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list projects, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	sortProjects(projects)
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, project := range projects {
+			if !filter.matches(project) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = project.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, models.ProjectIdentityModel{Id: types.StringValue(project.Id)})...)
+
+			if req.IncludeResource {
+				model := models.ProjectResourceModel{ForceDestroy: types.BoolValue(false)}
+				var timeoutsDiags diag.Diagnostics
+				model.Timeouts, timeoutsDiags = nullTimeouts(ctx, req)
+				result.Diagnostics.Append(timeoutsDiags...)
+				model.Read(project)
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
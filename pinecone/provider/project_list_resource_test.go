@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProjectListResource_mock(t *testing.T) {
+	server := newMockPineconeServer(t)
+	prodId := server.AddProject("prod")
+	server.AddProject("staging")
+	prodEuId := server.AddProject("prod-eu")
+
+	results := server.List(t, "pinecone_project", map[string]tftypes.Value{
+		"name_regex": tftypes.NewValue(tftypes.String, "^prod"),
+	}, true, 0)
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	for i, expected := range []struct{ name, id string }{{"prod", prodId}, {"prod-eu", prodEuId}} {
+		result := results[i]
+		for _, d := range result.Diagnostics {
+			t.Fatalf("unexpected diagnostic for %s: %s: %s", result.DisplayName, d.Summary, d.Detail)
+		}
+		if result.DisplayName != expected.name {
+			t.Errorf("result %d: expected %s, got %s", i, expected.name, result.DisplayName)
+		}
+		if !result.Identity["id"].Equal(tftypes.NewValue(tftypes.String, expected.id)) {
+			t.Errorf("identity of %s: got %s", expected.name, result.Identity["id"])
+		}
+		if !result.Resource["force_destroy"].Equal(tftypes.NewValue(tftypes.Bool, false)) {
+			t.Errorf("force_destroy of %s: got %s", expected.name, result.Resource["force_destroy"])
+		}
+	}
+}
+
+func TestProjectListResource_mock_invalidTimestamp(t *testing.T) {
+	server := newMockPineconeServer(t)
+
+	results := server.List(t, "pinecone_project", map[string]tftypes.Value{
+		"created_after": tftypes.NewValue(tftypes.String, "yesterday"),
+	}, false, 0)
+	if len(results) != 1 || len(results[0].Diagnostics) != 1 || results[0].Diagnostics[0].Summary != "Invalid created_after" {
+		t.Fatalf("expected an Invalid created_after diagnostic, got %+v", results)
+	}
+}
@@ -6,21 +6,32 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
 )
 
+const (
+	defaultProjectDeleteTimeout time.Duration = 10 * time.Minute
+)
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProjectResource{}
 var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithIdentity = &ProjectResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectResource{}
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{PineconeResource: &PineconeResource{}}
@@ -73,6 +84,38 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "The timestamp when the project was created.",
 				Computed:            true,
 			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to delete everything inside the project when the project is destroyed. Default is `false`. " +
+					"When `true`, every index (after disabling its deletion protection), collection, assistant and API key in the project is deleted before the project itself. " +
+					"When `false`, planning to destroy a project that still contains indexes, collections, assistants or API keys fails and lists them. " +
+					"The plan cannot tell which of them are destroyed in the same run, so resources inside the project must be destroyed by an earlier run, or `force_destroy` set. " +
+					"API keys are deleted last. When the provider's `project_id` is this project, its own API key is one of them, and the plan shows a warning.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx,
+				timeouts.Opts{
+					Delete: true,
+					DeleteDescription: `Timeout defaults to 10 mins. Accepts a string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) ` +
+						`consisting of numbers and unit suffixes, such as "30s" or "2h45m". Valid time units are ` +
+						`"s" (seconds), "m" (minutes), "h" (hours). ` +
+						`It bounds each wait of the deletion: for the contents of the project to be deleted when force_destroy is set, and for the project itself.`,
+				},
+			),
+		},
+	}
+}
+
+func (r *ProjectResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the project.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -117,19 +160,11 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Set the computed values
-	data.Id = types.StringValue(project.Id)
-	data.Name = types.StringValue(project.Name)
-	data.OrganizationId = types.StringValue(project.OrganizationId)
-	data.ForceEncryptionWithCmek = types.BoolValue(project.ForceEncryptionWithCmek)
-	data.MaxPods = types.Int64Value(int64(project.MaxPods))
-	if project.CreatedAt != nil {
-		data.CreatedAt = types.StringValue(project.CreatedAt.Format(time.RFC3339))
-	} else {
-		data.CreatedAt = types.StringNull()
-	}
+	data.Read(project)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.ProjectIdentityModel{Id: data.Id})...)
 }
 
 func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -142,6 +177,10 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	// The identity only depends on the prior state. It is set before describing the project, as
+	// it is also required when the project no longer exists.
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.ProjectIdentityModel{Id: data.Id})...)
+
 	// Check if admin client is available
 	if r.adminClient == nil {
 		resp.Diagnostics.AddError("Admin client not configured", "Admin client credentials (client_id and client_secret) are required to read projects.")
@@ -160,16 +199,10 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Update the model with the found project
-	data.Id = types.StringValue(project.Id)
-	data.Name = types.StringValue(project.Name)
-	data.OrganizationId = types.StringValue(project.OrganizationId)
-	data.ForceEncryptionWithCmek = types.BoolValue(project.ForceEncryptionWithCmek)
-	data.MaxPods = types.Int64Value(int64(project.MaxPods))
-	if project.CreatedAt != nil {
-		data.CreatedAt = types.StringValue(project.CreatedAt.Format(time.RFC3339))
-	} else {
-		data.CreatedAt = types.StringNull()
+	if data.ForceDestroy.IsNull() || data.ForceDestroy.IsUnknown() {
+		data.ForceDestroy = types.BoolValue(false)
 	}
+	data.Read(project)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -222,6 +255,7 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	if updateParams.Name == nil && updateParams.ForceEncryptionWithCmek == nil && updateParams.MaxPods == nil {
 		// No changes, just save the current state
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, models.ProjectIdentityModel{Id: state.Id})...)
 		return
 	}
 
@@ -233,19 +267,11 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Update the model with the updated project
-	data.Id = types.StringValue(updatedProject.Id)
-	data.Name = types.StringValue(updatedProject.Name)
-	data.OrganizationId = types.StringValue(updatedProject.OrganizationId)
-	data.ForceEncryptionWithCmek = types.BoolValue(updatedProject.ForceEncryptionWithCmek)
-	data.MaxPods = types.Int64Value(int64(updatedProject.MaxPods))
-	if updatedProject.CreatedAt != nil {
-		data.CreatedAt = types.StringValue(updatedProject.CreatedAt.Format(time.RFC3339))
-	} else {
-		data.CreatedAt = types.StringNull()
-	}
+	data.Read(updatedProject)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.ProjectIdentityModel{Id: state.Id})...)
 }
 
 func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -264,48 +290,293 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	// Delete() is passed a default timeout to use if no value
+	// has been supplied in the Terraform configuration.
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultProjectDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := data.Id.ValueString()
+
+	if data.ForceDestroy.ValueBool() {
+		resp.Diagnostics.Append(r.emptyProject(ctx, projectId, deleteTimeout)...)
+	} else {
+		// The plan was checked, but resources may have been added to the project since.
+		resp.Diagnostics.Append(r.checkProjectEmpty(ctx, data)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete the project
-	err := r.adminClient.Project.Delete(ctx, data.Id.ValueString())
+	err := r.adminClient.Project.Delete(ctx, projectId)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to delete project", err.Error())
 		return
 	}
 
-	// Wait for project to be deleted with simplified verification
-	err = retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
-		// Try to describe the specific project to check if it still exists
-		_, err := r.adminClient.Project.Describe(ctx, data.Id.ValueString())
+	// Wait for project to be deleted
+	err = retry.RetryContext(ctx, deleteTimeout, func() *retry.RetryError {
+		_, err := r.adminClient.Project.Describe(ctx, projectId)
 		if err != nil {
-			// If we can't describe the project, it's likely deleted
-			if strings.Contains(err.Error(), "not found") ||
-				strings.Contains(err.Error(), "NOT_FOUND") ||
-				strings.Contains(err.Error(), "404") {
+			if isNotFoundError(err) {
 				return nil // Project is deleted
 			}
-			// For other errors, retry
-			return retry.RetryableError(fmt.Errorf("deletion verification in progress, retrying: %v", err))
+			return retry.NonRetryableError(err)
 		}
 
 		// Project still exists, retry
 		return retry.RetryableError(fmt.Errorf("project not deleted yet"))
 	})
 	if err != nil {
-		// If we get a retryable error that's related to deletion verification,
-		// we can assume the project was likely deleted successfully
-		if strings.Contains(err.Error(), "deletion verification in progress") ||
-			strings.Contains(err.Error(), "not found") {
-			// Log a warning but don't fail the deletion
-			// The project deletion was successful, but verification failed due to timing issues
-			return
-		}
 		resp.Diagnostics.AddError("Failed to wait for project to be deleted.", err.Error())
 		return
 	}
 }
 
-func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: project_id
-	projectId := req.ID
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the project is being created.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state models.ProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(r.modifyDestroyPlan(ctx, state)...)
+		return
+	}
+
+	var plan models.ProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// CMEK encryption cannot be disabled once enabled, so reject the change at plan time
+	// instead of letting the update fail at apply.
+	if state.ForceEncryptionWithCmek.ValueBool() {
+		if plan.ForceEncryptionWithCmek.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("force_encryption_with_cmek"), state.ForceEncryptionWithCmek)...)
+		} else if !plan.ForceEncryptionWithCmek.IsNull() && !plan.ForceEncryptionWithCmek.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("force_encryption_with_cmek"),
+				"Cannot disable CMEK encryption",
+				fmt.Sprintf("Project %q enforces encryption with a customer-managed encryption key (CMEK). Once enabled, CMEK encryption cannot be disabled.", state.Name.ValueString()),
+			)
+		}
+	}
+
+	// Warn when max_pods is lowered below the pods already used by the project's indexes.
+	if plan.MaxPods.IsNull() || plan.MaxPods.IsUnknown() || plan.MaxPods.ValueInt64() >= state.MaxPods.ValueInt64() {
+		return
+	}
+	if r.providerData == nil || r.adminClient == nil {
+		return
+	}
+
+	podsInUse, err := r.podsInUse(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("max_pods"),
+			"Unable to count pods in use",
+			fmt.Sprintf("Could not check the pods used by the indexes in project %q: %s", state.Name.ValueString(), err),
+		)
+		return
+	}
+	if plan.MaxPods.ValueInt64() < podsInUse {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("max_pods"),
+			"max_pods is lower than the pods in use",
+			fmt.Sprintf("Project %q has pod-based indexes using %d pods, but max_pods would be set to %d. "+
+				"Existing indexes keep running, but they cannot be scaled up and no new pods can be created until usage drops below the limit.",
+				state.Name.ValueString(), podsInUse, plan.MaxPods.ValueInt64()),
+		)
+	}
+}
+
+// modifyDestroyPlan checks a project planned for destruction. Without force_destroy, the project
+// must be empty, so that the plan rather than the apply reports what is still inside. With it,
+// a warning is shown when the provider's own API key is among the keys to be deleted.
+func (r *ProjectResource) modifyDestroyPlan(ctx context.Context, state models.ProjectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.providerData == nil || r.adminClient == nil {
+		return diags
+	}
+
+	if !state.ForceDestroy.ValueBool() {
+		return r.checkProjectEmpty(ctx, state)
+	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), projectId)...)
+	if r.providerData.Client != nil && r.providerData.projectId == state.Id.ValueString() {
+		diags.AddWarning(
+			"Provider API key will be deleted",
+			fmt.Sprintf("Project %q is the provider's project_id, so force_destroy deletes the API key the provider uses, after the indexes and collections of the project are gone. "+
+				"Other resources relying on that API key cannot be managed once the project is destroyed.", state.Name.ValueString()),
+		)
+	}
+	return diags
+}
+
+// checkProjectEmpty reports an error listing what the project still contains, or why its
+// contents could not be listed.
+func (r *ProjectResource) checkProjectEmpty(ctx context.Context, state models.ProjectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.providerData == nil {
+		return diags
+	}
+
+	contents, err := r.providerData.listProjectContents(ctx, state.Id.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to check that the project is empty",
+			fmt.Sprintf("Could not list the contents of project %q: %s\n\nSet force_destroy = true to delete the project along with its contents without this check.", state.Name.ValueString(), err),
+		)
+		return diags
+	}
+	if !contents.IsEmpty() {
+		diags.AddError(
+			"Project is not empty",
+			fmt.Sprintf("Project %q still contains:%s\n\nDelete these resources first, or set force_destroy = true to delete them together with the project.", state.Name.ValueString(), contents),
+		)
+	}
+	return diags
+}
+
+// podsInUse returns the total number of pods used by the pod-based indexes in the project.
+func (r *ProjectResource) podsInUse(ctx context.Context, projectId string) (int64, error) {
+	client, err := r.providerData.NewProjectClient(ctx, projectId)
+	if err != nil {
+		return 0, err
+	}
+
+	indexes, err := client.ListIndexes(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var pods int64
+	for _, index := range indexes {
+		if index.Spec == nil || index.Spec.Pod == nil {
+			continue
+		}
+		if index.Spec.Pod.PodCount > 0 {
+			pods += int64(index.Spec.Pod.PodCount)
+		} else {
+			pods += indexPods(int64(index.Spec.Pod.ShardCount), int64(index.Spec.Pod.Replicas))
+		}
+	}
+	return pods, nil
+}
+
+// emptyProject deletes every index, collection, assistant and API key in the project,
+// so that the project itself can be deleted. The API keys are deleted last, once the indexes
+// and collections are gone, as the provider's own key may be among them.
+func (r *ProjectResource) emptyProject(ctx context.Context, projectId string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.providerData == nil {
+		diags.AddError("Admin client not configured", "Admin client credentials (client_id and client_secret) are required to delete the contents of a project.")
+		return diags
+	}
+
+	client, err := r.providerData.NewProjectClient(ctx, projectId)
+	if err != nil {
+		diags.AddError("Failed to create project client", err.Error())
+		return diags
+	}
+
+	indexes, err := client.ListIndexes(ctx)
+	if err != nil {
+		diags.AddError("Failed to list indexes", err.Error())
+		return diags
+	}
+	for _, index := range indexes {
+		if index.DeletionProtection == pinecone.DeletionProtectionEnabled {
+			_, err := client.ConfigureIndex(ctx, index.Name, pinecone.ConfigureIndexParams{DeletionProtection: pinecone.DeletionProtectionDisabled})
+			if err != nil {
+				diags.AddError("Failed to disable deletion protection", fmt.Sprintf("index %q: %s", index.Name, err))
+				return diags
+			}
+		}
+		tflog.Info(ctx, fmt.Sprintf("Deleting index '%s' from project '%s'", index.Name, projectId))
+		if err := client.DeleteIndex(ctx, index.Name); err != nil && !isNotFoundError(err) {
+			diags.AddError("Failed to delete index", fmt.Sprintf("index %q: %s", index.Name, err))
+			return diags
+		}
+	}
+
+	collections, err := client.ListCollections(ctx)
+	if err != nil {
+		diags.AddError("Failed to list collections", err.Error())
+		return diags
+	}
+	for _, collection := range collections {
+		tflog.Info(ctx, fmt.Sprintf("Deleting collection '%s' from project '%s'", collection.Name, projectId))
+		if err := client.DeleteCollection(ctx, collection.Name); err != nil && !isNotFoundError(err) {
+			diags.AddError("Failed to delete collection", fmt.Sprintf("collection %q: %s", collection.Name, err))
+			return diags
+		}
+	}
+
+	assistants, err := r.providerData.ListAssistants(ctx, projectId)
+	if err != nil {
+		diags.AddError("Failed to list assistants", err.Error())
+		return diags
+	}
+	for _, name := range assistants {
+		tflog.Info(ctx, fmt.Sprintf("Deleting assistant '%s' from project '%s'", name, projectId))
+		if err := r.providerData.DeleteAssistant(ctx, projectId, name); err != nil {
+			diags.AddError("Failed to delete assistant", err.Error())
+			return diags
+		}
+	}
+
+	// Index and collection deletion is asynchronous, so wait until they are gone.
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		indexes, err := client.ListIndexes(ctx)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		collections, err := client.ListCollections(ctx)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if len(indexes) > 0 || len(collections) > 0 {
+			return retry.RetryableError(fmt.Errorf("project not empty yet: %d indexes and %d collections remaining", len(indexes), len(collections)))
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddError("Failed to wait for project contents to be deleted.", err.Error())
+		return diags
+	}
+
+	apiKeys, err := r.adminClient.APIKey.List(ctx, projectId)
+	if err != nil {
+		diags.AddError("Failed to list API keys", err.Error())
+		return diags
+	}
+	for _, key := range apiKeys {
+		tflog.Info(ctx, fmt.Sprintf("Deleting API key '%s' from project '%s'", key.Id, projectId))
+		if err := r.adminClient.APIKey.Delete(ctx, key.Id); err != nil && !isNotFoundError(err) {
+			diags.AddError("Failed to delete API key", fmt.Sprintf("API key %q: %s", key.Id, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: project_id, or an identity with an import block
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
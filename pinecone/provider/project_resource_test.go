@@ -1,10 +1,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
 )
 
 func TestAccProjectResource(t *testing.T) {
@@ -103,6 +113,11 @@ func TestAccProjectResourceWithCMEK(t *testing.T) {
 					resource.TestCheckResourceAttr("pinecone_project.test", "force_encryption_with_cmek", "true"),
 				),
 			},
+			// Disabling CMEK is rejected at plan time
+			{
+				Config:      testAccProjectResourceWithOptionsConfig("test-project-with-cmek", false, 0),
+				ExpectError: regexp.MustCompile("Cannot disable CMEK encryption"),
+			},
 		},
 	})
 }
@@ -115,3 +130,222 @@ resource "pinecone_project" "test" {
 }
 `, name)
 }
+
+func TestAccProjectResourceForceDestroy(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAdmin(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a project whose contents are deleted along with it
+			{
+				Config: testAccProjectResourceForceDestroyConfig("test-project-force-destroy", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_project.test", "name", "test-project-force-destroy"),
+					resource.TestCheckResourceAttr("pinecone_project.test", "force_destroy", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectResourceForceDestroyConfig(name string, forceDestroy bool) string {
+	return fmt.Sprintf(`
+resource "pinecone_project" "test" {
+  name          = %[1]q
+  force_destroy = %[2]t
+
+  timeouts {
+    delete = "2m"
+  }
+}
+`, name, forceDestroy)
+}
+
+func TestProjectResource_mock(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		CheckDestroy:             server.CheckDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + testAccProjectResourceConfig("mock-project"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_project.test", "name", "mock-project"),
+					resource.TestCheckResourceAttr("pinecone_project.test", "organization_id", "mock-organization"),
+					resource.TestCheckResourceAttr("pinecone_project.test", "force_encryption_with_cmek", "false"),
+					resource.TestCheckResourceAttrSet("pinecone_project.test", "id"),
+					resource.TestCheckResourceAttrSet("pinecone_project.test", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "pinecone_project.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			// Update and Read testing
+			{
+				Config: server.ProviderConfig() + testAccProjectResourceWithOptionsConfig("mock-project-updated", false, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pinecone_project.test", "name", "mock-project-updated"),
+					resource.TestCheckResourceAttr("pinecone_project.test", "max_pods", "5"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestProjectResource_mock_notEmpty(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+
+	// addIndex creates an index in the project behind Terraform's back.
+	addIndex := func(state *terraform.State) error {
+		server.AddIndex(state.RootModule().Resources["pinecone_project.test"].Primary.ID, "mock-index")
+		return nil
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		CheckDestroy:             server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccProjectResourceForceDestroyConfig("mock-project", false),
+				Check:  addIndex,
+			},
+			// Planning to destroy a project holding an index fails and lists it
+			{
+				Config:      server.ProviderConfig(),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Project is not empty.*mock-index`),
+			},
+			// So does planning it when the contents cannot be listed
+			{
+				PreConfig:   func() { server.FailNext(http.MethodGet, "/indexes", http.StatusInternalServerError, 1) },
+				Config:      server.ProviderConfig(),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Unable to check that the project is empty`),
+			},
+			// A project holding an index is not deleted
+			{
+				Config:      server.ProviderConfig() + testAccProjectResourceForceDestroyConfig("mock-project", false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`(?s)Project is not empty.*mock-index`),
+			},
+			// Unless its contents are deleted along with it
+			{
+				Config: server.ProviderConfig() + testAccProjectResourceForceDestroyConfig("mock-project", true),
+			},
+		},
+	})
+}
+
+func TestProjectResource_mock_maxPodsBelowUsage(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	projectId := server.AddProject("mock-project")
+	server.AddPodIndex(projectId, "mock-pod-index", 2, 3)
+
+	prior := map[string]tftypes.Value{
+		"id":                         tftypes.NewValue(tftypes.String, projectId),
+		"name":                       tftypes.NewValue(tftypes.String, "mock-project"),
+		"organization_id":            tftypes.NewValue(tftypes.String, "mock-organization"),
+		"force_encryption_with_cmek": tftypes.NewValue(tftypes.Bool, false),
+		"max_pods":                   tftypes.NewValue(tftypes.Number, 10),
+		"created_at":                 tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+		"force_destroy":              tftypes.NewValue(tftypes.Bool, false),
+	}
+	config := func(maxPods int) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"name":     tftypes.NewValue(tftypes.String, "mock-project"),
+			"max_pods": tftypes.NewValue(tftypes.Number, maxPods),
+		}
+	}
+
+	// The index uses 2 shards × 3 replicas = 6 pods.
+	diags := server.PlanUpdate(t, "pinecone_project", prior, config(5))
+	if len(diags) != 1 || diags[0].Severity != tfprotov6.DiagnosticSeverityWarning || diags[0].Summary != "max_pods is lower than the pods in use" {
+		t.Fatalf("expected a max_pods warning, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail, "using 6 pods") {
+		t.Errorf("expected the warning to count 6 pods, got %q", diags[0].Detail)
+	}
+
+	// A limit covering the pods in use is accepted silently.
+	if diags := server.PlanUpdate(t, "pinecone_project", prior, config(6)); len(diags) != 0 {
+		t.Fatalf("expected no diagnostics, got %v", diags)
+	}
+}
+
+func TestProjectResource_mock_cannotDisableCmek(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testMockPreCheck(t) },
+		ProtoV6ProviderFactories: server.ProviderFactories(),
+		CheckDestroy:             server.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccProjectResourceWithOptionsConfig("mock-project", true, 0),
+				Check:  resource.TestCheckResourceAttr("pinecone_project.test", "force_encryption_with_cmek", "true"),
+			},
+			{
+				Config:      server.ProviderConfig() + testAccProjectResourceWithOptionsConfig("mock-project", false, 0),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Cannot disable CMEK encryption`),
+			},
+		},
+	})
+}
+
+func TestProjectResource_mock_importByIdentity(t *testing.T) {
+	t.Parallel()
+	server := newMockPineconeServer(t)
+	projectId := server.AddProject("mock-project")
+
+	state, identity, diags := server.ImportByIdentity(t, "pinecone_project", map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, projectId),
+	})
+	for _, d := range diags {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if !state["name"].Equal(tftypes.NewValue(tftypes.String, "mock-project")) {
+		t.Errorf("name: got %s", state["name"])
+	}
+	if !identity["id"].Equal(tftypes.NewValue(tftypes.String, projectId)) {
+		t.Errorf("identity id: got %s", identity["id"])
+	}
+}
+
+func TestProjectResource_destroyPlanWarnsAboutProviderApiKey(t *testing.T) {
+	r := &ProjectResource{PineconeResource: &PineconeResource{
+		adminClient:  &pinecone.AdminClient{},
+		providerData: &PineconeProviderData{Client: &pinecone.Client{}, projectId: "project-1"},
+	}}
+	state := models.ProjectResourceModel{
+		Id:           types.StringValue("project-1"),
+		Name:         types.StringValue("mock-project"),
+		ForceDestroy: types.BoolValue(true),
+	}
+
+	diags := r.modifyDestroyPlan(context.Background(), state)
+	if diags.HasError() || diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Provider API key will be deleted" {
+		t.Fatalf("expected a warning about the provider API key, got %v", diags)
+	}
+
+	// Another project does not hold the provider's API key.
+	state.Id = types.StringValue("project-2")
+	if diags := r.modifyDestroyPlan(context.Background(), state); len(diags) != 0 {
+		t.Fatalf("expected no diagnostics, got %v", diags)
+	}
+}
@@ -3,12 +3,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
 )
 
@@ -31,9 +36,25 @@ func (d *ProjectsDataSource) Metadata(ctx context.Context, req datasource.Metada
 func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Projects data source",
+		MarkdownDescription: "Projects data source. Projects are sorted by name.",
 
 		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list projects whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Only list projects created after this RFC 3339 timestamp, e.g. `2025-01-01T00:00:00Z`.",
+				Optional:            true,
+			},
+			"created_before": schema.StringAttribute{
+				MarkdownDescription: "Only list projects created before this RFC 3339 timestamp, e.g. `2025-01-01T00:00:00Z`.",
+				Optional:            true,
+			},
+			"force_encryption_with_cmek": schema.BoolAttribute{
+				MarkdownDescription: "Only list projects that do, or do not, force encryption with a customer-managed encryption key (CMEK).",
+				Optional:            true,
+			},
 			"projects": schema.ListNestedAttribute{
 				MarkdownDescription: "List of the projects in your organization",
 				Computed:            true,
@@ -90,14 +111,26 @@ func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	filter, diags := newProjectFilter(data.ProjectFilterModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	projects, err := d.adminClient.Project.List(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list projects, got error: %s", err))
 		return
 	}
 
+	sortProjects(projects)
+
 	// Convert projects to models and append to the list
+	data.Projects = []models.ProjectModel{}
 	for _, p := range projects {
+		if !filter.matches(p) {
+			continue
+		}
 		data.Projects = append(data.Projects, *models.NewProjectModel(p))
 	}
 
@@ -105,3 +138,78 @@ func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	data.Id = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// sortProjects sorts projects by name, so the list does not change when the API reorders them.
+// Project names are not unique, so projects sharing a name are ordered by ID.
+func sortProjects(projects []*pinecone.Project) {
+	sort.Slice(projects, func(i, j int) bool {
+		if projects[i].Name != projects[j].Name {
+			return projects[i].Name < projects[j].Name
+		}
+		return projects[i].Id < projects[j].Id
+	})
+}
+
+// projectFilter selects the projects listed by the data source and the list resource. Unset
+// fields match every project.
+type projectFilter struct {
+	nameRegex               *regexp.Regexp
+	createdAfter            *time.Time
+	createdBefore           *time.Time
+	forceEncryptionWithCmek *bool
+}
+
+// newProjectFilter builds the filter described by the filter attributes of the data source or
+// list resource configuration.
+func newProjectFilter(data models.ProjectFilterModel) (projectFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var filter projectFilter
+
+	if !data.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return filter, diags
+		}
+		filter.nameRegex = nameRegex
+	}
+
+	if !data.ForceEncryptionWithCmek.IsNull() {
+		filter.forceEncryptionWithCmek = data.ForceEncryptionWithCmek.ValueBoolPointer()
+	}
+
+	filter.createdAfter = parseTimestampFilter(data.CreatedAfter, "created_after", &diags)
+	filter.createdBefore = parseTimestampFilter(data.CreatedBefore, "created_before", &diags)
+
+	return filter, diags
+}
+
+func (f projectFilter) matches(project *pinecone.Project) bool {
+	if f.nameRegex != nil && !f.nameRegex.MatchString(project.Name) {
+		return false
+	}
+	if f.forceEncryptionWithCmek != nil && project.ForceEncryptionWithCmek != *f.forceEncryptionWithCmek {
+		return false
+	}
+	if f.createdAfter != nil && (project.CreatedAt == nil || !project.CreatedAt.After(*f.createdAfter)) {
+		return false
+	}
+	if f.createdBefore != nil && (project.CreatedAt == nil || !project.CreatedAt.Before(*f.createdBefore)) {
+		return false
+	}
+	return true
+}
+
+// parseTimestampFilter parses an optional RFC 3339 filter attribute. It returns nil when the
+// attribute is unset or invalid; an invalid value is reported on diags.
+func parseTimestampFilter(value types.String, attribute string, diags *diag.Diagnostics) *time.Time {
+	if value.IsNull() {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root(attribute), "Invalid "+attribute, fmt.Sprintf("Expected an RFC 3339 timestamp: %s", err))
+		return nil
+	}
+	return &t
+}
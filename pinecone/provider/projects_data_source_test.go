@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 )
 
 func TestAccProjectsDataSource(t *testing.T) {
@@ -39,6 +40,42 @@ func TestAccProjectsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.pinecone_projects.test", "projects.0.created_at"), // Check first project has created_at
 				),
 			},
+			{
+				// Filters that no project can match
+				Config: fmt.Sprintf(`
+					provider "pinecone" {
+						client_id     = "%s"
+						client_secret = "%s"
+					}
+
+					data "pinecone_projects" "test" {
+						name_regex     = "^tftest-"
+						created_before = "2000-01-01T00:00:00Z"
+					}
+				`, clientId, clientSecret),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pinecone_projects.test", "projects.#", "0"),
+				),
+			},
 		},
 	})
 }
+
+func TestSortProjects(t *testing.T) {
+	projects := []*pinecone.Project{
+		{Id: "3", Name: "b"},
+		{Id: "2", Name: "a"},
+		{Id: "4", Name: "a"},
+		{Id: "1", Name: "a"},
+	}
+
+	sortProjects(projects)
+
+	var got []string
+	for _, project := range projects {
+		got = append(got, project.Name+project.Id)
+	}
+	if want := "[a1 a2 a4 b3]"; fmt.Sprint(got) != want {
+		t.Fatalf("expected %s, got %v", want, got)
+	}
+}
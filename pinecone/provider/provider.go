@@ -5,9 +5,15 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"os"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +23,8 @@ import (
 
 // Ensure PineconeProvider satisfies various provider interfaces.
 var _ provider.Provider = &PineconeProvider{}
+var _ provider.ProviderWithFunctions = &PineconeProvider{}
+var _ provider.ProviderWithListResources = &PineconeProvider{}
 
 // PineconeProvider defines the provider implementation.
 type PineconeProvider struct {
@@ -24,6 +32,10 @@ type PineconeProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// transport sends the HTTP requests of the provider and of the SDK clients it
+	// builds. It is only set in tests, to route them to a mock server.
+	transport http.RoundTripper
 }
 
 // PineconeProviderModel describes the provider data model.
@@ -31,12 +43,35 @@ type PineconeProviderModel struct {
 	ApiKey       types.String `tfsdk:"api_key"`
 	ClientId     types.String `tfsdk:"client_id"`
 	ClientSecret types.String `tfsdk:"client_secret"`
+	Host         types.String `tfsdk:"host"`
+	ProjectId    types.String `tfsdk:"project_id"`
 }
 
 // PineconeProviderData holds the provider data including both regular and admin clients.
 type PineconeProviderData struct {
 	Client      *pinecone.Client
 	AdminClient *pinecone.AdminClient
+
+	// Admin credentials, kept to build clients scoped to other projects.
+	clientId     string
+	clientSecret string
+
+	// Base URL of the Pinecone control plane.
+	controllerURL string
+
+	// ID of the project the API key belongs to, when configured.
+	projectId string
+
+	// httpClient sends the requests the provider makes itself rather than through the SDK.
+	httpClient *http.Client
+
+	tokenMu     sync.Mutex
+	token       string
+	tokenExpiry time.Time
+
+	// Embedding models described during this run, keyed by model name.
+	modelsMu sync.Mutex
+	models   map[string]*pinecone.ModelInfo
 }
 
 func (p *PineconeProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -67,6 +102,14 @@ credentials before use. You can provide credentials via the PINECONE_API_KEY env
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Pinecone control plane URL. Defaults to `https://api.pinecone.io`. Can be configured by setting PINECONE_CONTROLLER_HOST environment variable. Only control plane requests are sent to this host: the admin credentials are always exchanged for an access token at `https://login.pinecone.io`.",
+				Optional:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project the API key belongs to. It is recorded in the identity of indexes and collections, and checked when they are imported by identity. Can be configured by setting PINECONE_PROJECT_ID environment variable.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -97,13 +140,34 @@ func (p *PineconeProvider) Configure(ctx context.Context, req provider.Configure
 		clientSecret = data.ClientSecret.ValueString()
 	}
 
+	host := os.Getenv("PINECONE_CONTROLLER_HOST")
+	if !data.Host.IsNull() {
+		host = data.Host.ValueString()
+	}
+
+	projectId := os.Getenv("PINECONE_PROJECT_ID")
+	if !data.ProjectId.IsNull() {
+		projectId = data.ProjectId.ValueString()
+	}
+
 	// Create provider data structure
-	providerData := &PineconeProviderData{}
+	providerData := &PineconeProviderData{
+		controllerURL: pineconeControllerURL,
+		projectId:     projectId,
+		httpClient:    &http.Client{Timeout: httpTimeout, Transport: p.transport},
+	}
+	if host != "" {
+		providerData.controllerURL = host
+		if !strings.Contains(host, "://") {
+			providerData.controllerURL = "https://" + host
+		}
+	}
 
 	// Create regular client only if API key is provided
 	if apiKey != "" {
 		client, err := pinecone.NewClient(pinecone.NewClientParams{
 			ApiKey:    apiKey,
+			Host:      host,
 			SourceTag: "terraform",
 		})
 		if err != nil {
@@ -115,9 +179,14 @@ func (p *PineconeProvider) Configure(ctx context.Context, req provider.Configure
 
 	// Create admin client only if admin credentials are provided
 	if clientId != "" && clientSecret != "" {
+		providerData.clientId = clientId
+		providerData.clientSecret = clientSecret
+
 		adminClient, err := pinecone.NewAdminClient(pinecone.NewAdminClientParams{
 			ClientId:     clientId,
 			ClientSecret: clientSecret,
+			Host:         host,
+			RestClient:   providerData.httpClient,
 		})
 		if err != nil {
 			resp.Diagnostics.AddError("Failed to create pinecone admin client", err.Error())
@@ -134,6 +203,7 @@ func (p *PineconeProvider) Configure(ctx context.Context, req provider.Configure
 
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
+	resp.ListResourceData = providerData
 }
 
 func (p *PineconeProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -153,6 +223,27 @@ func (p *PineconeProvider) DataSources(ctx context.Context) []func() datasource.
 		NewIndexDataSource,
 		NewProjectsDataSource,
 		NewProjectDataSource,
+		NewInferenceModelsDataSource,
+		NewEmbeddingsDataSource,
+		NewRerankDataSource,
+	}
+}
+
+func (p *PineconeProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewValidIndexNameFunction,
+		NewSanitizeIndexNameFunction,
+		NewSanitizeTagsFunction,
+		NewPodCountFunction,
+	}
+}
+
+func (p *PineconeProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewCollectionListResource,
+		NewIndexListResource,
+		NewApiKeyListResource,
+		NewProjectListResource,
 	}
 }
 
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"github.com/pinecone-io/terraform-provider-pinecone/pinecone/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RerankDataSource{}
+
+func NewRerankDataSource() datasource.DataSource {
+	return &RerankDataSource{PineconeDatasource: &PineconeDatasource{}}
+}
+
+// RerankDataSource defines the data source implementation.
+type RerankDataSource struct {
+	*PineconeDatasource
+}
+
+func (d *RerankDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rerank"
+}
+
+func (d *RerankDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The `pinecone_rerank` data source ranks documents by their relevance to a query with a reranking model hosted by Pinecone. " +
+			"Learn more about reranking in the [docs](https://docs.pinecone.io/guides/search/rerank-results).",
+
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				MarkdownDescription: "The name of the reranking model to use.",
+				Required:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The query to rank the documents against.",
+				Required:            true,
+			},
+			"documents": schema.ListAttribute{
+				MarkdownDescription: "The documents to rank. Each document is a map of fields; by default the `text` field is ranked.",
+				Required:            true,
+				ElementType:         types.MapType{ElemType: types.StringType},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"top_n": schema.Int64Attribute{
+				MarkdownDescription: "The number of results to return. Defaults to the number of documents.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rank_fields": schema.ListAttribute{
+				MarkdownDescription: "The document fields to rank on. Defaults to `[\"text\"]`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "The ranked documents, most relevant first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index": schema.Int64Attribute{
+							MarkdownDescription: "The position of the document in `documents`.",
+							Computed:            true,
+						},
+						"score": schema.Float64Attribute{
+							MarkdownDescription: "The relevance score of the document, between 0 and 1.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Rerank identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *RerankDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.RerankDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var documents []map[string]string
+	resp.Diagnostics.Append(data.Documents.ElementsAs(ctx, &documents, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	returnDocuments := false
+	rerankReq := pinecone.RerankRequest{
+		Model:           data.Model.ValueString(),
+		Query:           data.Query.ValueString(),
+		Documents:       make([]pinecone.Document, 0, len(documents)),
+		ReturnDocuments: &returnDocuments,
+	}
+	for _, document := range documents {
+		doc := pinecone.Document{}
+		for k, v := range document {
+			doc[k] = v
+		}
+		rerankReq.Documents = append(rerankReq.Documents, doc)
+	}
+
+	if !data.TopN.IsNull() {
+		topN := int(data.TopN.ValueInt64())
+		rerankReq.TopN = &topN
+	}
+
+	if !data.RankFields.IsNull() {
+		var rankFields []string
+		resp.Diagnostics.Append(data.RankFields.ElementsAs(ctx, &rankFields, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		rerankReq.RankFields = &rankFields
+	}
+
+	ranked, err := d.client.Inference.Rerank(ctx, &rerankReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rerank documents, got error: %s", err))
+		return
+	}
+
+	data.Results = make([]models.RankedDocumentModel, 0, len(ranked.Data))
+	for _, document := range ranked.Data {
+		data.Results = append(data.Results, models.RankedDocumentModel{
+			Index: types.Int64Value(int64(document.Index)),
+			Score: types.Float64Value(float64(document.Score)),
+		})
+	}
+
+	// Save data into Terraform state
+	data.Id = types.StringValue(strconv.FormatInt(time.Now().Unix(), 10))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRerankDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccRerankDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pinecone_rerank.test", "id"),
+					resource.TestCheckResourceAttr("data.pinecone_rerank.test", "results.#", "2"),
+					resource.TestCheckResourceAttr("data.pinecone_rerank.test", "results.0.index", "1"),
+					resource.TestCheckResourceAttrSet("data.pinecone_rerank.test", "results.0.score"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRerankDataSourceConfig = `
+provider "pinecone" {
+}
+
+data "pinecone_rerank" "test" {
+	model = "bge-reranker-v2-m3"
+	query = "Which company makes the iPhone?"
+	top_n = 2
+	documents = [
+		{ text = "Apple is a popular fruit known for its sweetness and crisp texture." },
+		{ text = "The tech company Apple is known for its innovative products like the iPhone." },
+		{ text = "Many people enjoy eating apples as a healthy snack." },
+	]
+}
+`
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// invalidIndexNameChars matches runs of characters that are not allowed in an index name.
+var invalidIndexNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// sanitizeIndexName turns name into a valid index name: it is lowercased, every run of
+// invalid characters and hyphens becomes a single hyphen, leading and trailing hyphens are
+// dropped and the result is truncated to the maximum index name length.
+func sanitizeIndexName(name string) (string, error) {
+	sanitized := invalidIndexNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	sanitized = strings.Trim(sanitized, "-")
+	if len(sanitized) > maxIndexNameLength {
+		sanitized = strings.TrimRight(sanitized[:maxIndexNameLength], "-")
+	}
+
+	if sanitized == "" {
+		return "", fmt.Errorf("%q has no alphanumeric characters to build an index name from", name)
+	}
+	return sanitized, nil
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SanitizeIndexNameFunction{}
+
+func NewSanitizeIndexNameFunction() function.Function {
+	return &SanitizeIndexNameFunction{}
+}
+
+// SanitizeIndexNameFunction defines the function implementation.
+type SanitizeIndexNameFunction struct{}
+
+func (f *SanitizeIndexNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sanitize_index_name"
+}
+
+func (f *SanitizeIndexNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a valid index name from any string",
+		MarkdownDescription: "Builds a valid index name from a string such as a workspace or branch name. The string is lowercased, " +
+			"every run of characters other than lowercase letters and digits is replaced by a single '-', leading and trailing " +
+			"'-' are removed and the result is truncated to 45 characters. Fails when the string has no letters or digits.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The string to build the index name from.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SanitizeIndexNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	sanitized, err := sanitizeIndexName(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, sanitized))
+}
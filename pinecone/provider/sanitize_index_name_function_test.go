@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestSanitizeIndexName(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "my-index", want: "my-index"},
+		{name: "Feature/Add_Search", want: "feature-add-search"},
+		{name: "  staging  ", want: "staging"},
+		{name: "a--b__c", want: "a-b-c"},
+		{name: "-leading-and-trailing-", want: "leading-and-trailing"},
+		{name: "héllo wörld", want: "h-llo-w-rld"},
+		{name: strings.Repeat("a", 50), want: strings.Repeat("a", 45)},
+		{name: strings.Repeat("a", 44) + "_b", want: strings.Repeat("a", 44)},
+		{name: "", wantErr: true},
+		{name: "___", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := sanitizeIndexName(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("sanitizeIndexName(%q) error = %v, wantErr %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("sanitizeIndexName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+		if !tt.wantErr && !validIndexName(got) {
+			t.Errorf("sanitizeIndexName(%q) = %q, which is not a valid index name", tt.name, got)
+		}
+	}
+}
+
+func TestSanitizeIndexNameFunction(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::pinecone::sanitize_index_name("Feature/Add_Search")
+}
+`,
+				Check: resource.TestCheckOutput("test", "feature-add-search"),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::pinecone::sanitize_index_name("___")
+}
+`,
+				ExpectError: regexp.MustCompile(`no alphanumeric`),
+			},
+		},
+	})
+}
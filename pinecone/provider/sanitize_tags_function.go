@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// maxTagKeyLength and maxTagValueLength are the maximum lengths of index tag keys and values.
+	maxTagKeyLength   = 80
+	maxTagValueLength = 120
+)
+
+var (
+	// invalidTagKeyChars and invalidTagValueChars match the characters that are not allowed
+	// in index tag keys and values.
+	invalidTagKeyChars   = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+	invalidTagValueChars = regexp.MustCompile(`[^a-zA-Z0-9;@_\-.+ ]`)
+)
+
+// sanitizeTags replaces the characters that are not allowed in index tag keys and values
+// with '_' and truncates them to their maximum length. It fails when two keys end up the same.
+func sanitizeTags(tags map[string]string) (map[string]string, error) {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	sanitized := make(map[string]string, len(tags))
+	origins := make(map[string]string, len(tags))
+	for _, key := range keys {
+		sanitizedKey := truncate(invalidTagKeyChars.ReplaceAllString(key, "_"), maxTagKeyLength)
+		if sanitizedKey == "" {
+			return nil, fmt.Errorf("tag keys cannot be empty")
+		}
+		if origin, ok := origins[sanitizedKey]; ok {
+			return nil, fmt.Errorf("tag keys %q and %q both sanitize to %q", origin, key, sanitizedKey)
+		}
+		origins[sanitizedKey] = key
+		sanitized[sanitizedKey] = truncate(invalidTagValueChars.ReplaceAllString(tags[key], "_"), maxTagValueLength)
+	}
+	return sanitized, nil
+}
+
+// truncate shortens s to at most n bytes. It is only used on ASCII strings.
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SanitizeTagsFunction{}
+
+func NewSanitizeTagsFunction() function.Function {
+	return &SanitizeTagsFunction{}
+}
+
+// SanitizeTagsFunction defines the function implementation.
+type SanitizeTagsFunction struct{}
+
+func (f *SanitizeTagsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sanitize_tags"
+}
+
+func (f *SanitizeTagsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build valid index tags from any map of strings",
+		MarkdownDescription: "Builds valid index `tags` from a map of strings. Characters other than alphanumeric, '_' and '-' in keys, " +
+			"and other than alphanumeric, ';', '@', '_', '-', '.', '+' and ' ' in values, are replaced by '_'. Keys are truncated " +
+			"to 80 characters and values to 120 characters. Fails when two keys end up the same.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				MarkdownDescription: "The tags to sanitize.",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *SanitizeTagsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tags map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tags))
+	if resp.Error != nil {
+		return
+	}
+
+	sanitized, err := sanitizeTags(tags)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, sanitized))
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestSanitizeTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		tags    map[string]string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			tags: map[string]string{"team-name": "search", "owner_email": "jane@example.com", "note": "a; b + c"},
+			want: map[string]string{"team-name": "search", "owner_email": "jane@example.com", "note": "a; b + c"},
+		},
+		{
+			name: "invalid characters",
+			tags: map[string]string{"git/branch": "feature/search#1", "cost center": "R&D"},
+			want: map[string]string{"git_branch": "feature_search_1", "cost_center": "R_D"},
+		},
+		{
+			name: "long keys and values",
+			tags: map[string]string{strings.Repeat("k", 90): strings.Repeat("v", 130)},
+			want: map[string]string{strings.Repeat("k", 80): strings.Repeat("v", 120)},
+		},
+		{
+			name: "empty value",
+			tags: map[string]string{"remove": ""},
+			want: map[string]string{"remove": ""},
+		},
+		{
+			name: "empty",
+			tags: map[string]string{},
+			want: map[string]string{},
+		},
+		{
+			name:    "colliding keys",
+			tags:    map[string]string{"git/branch": "a", "git_branch": "b"},
+			wantErr: true,
+		},
+		{
+			name:    "empty key",
+			tags:    map[string]string{"": "a"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sanitizeTags(tt.tags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("sanitizeTags() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sanitizeTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanitizeTagsFunction(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  tags = provider::pinecone::sanitize_tags({
+    "git/branch" = "feature/search#1"
+    team         = "search"
+  })
+}
+
+output "branch" {
+  value = local.tags["git_branch"]
+}
+
+output "team" {
+  value = local.tags["team"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("branch", "feature_search_1"),
+					resource.TestCheckOutput("team", "search"),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::pinecone::sanitize_tags({
+    "git/branch" = "a"
+    "git_branch" = "b"
+  })
+}
+`,
+				ExpectError: regexp.MustCompile(`both sanitize to`),
+			},
+		},
+	})
+}
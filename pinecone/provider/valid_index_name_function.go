@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// maxIndexNameLength is the maximum length of an index name.
+const maxIndexNameLength = 45
+
+// indexNamePattern matches index names made of lowercase alphanumeric characters and
+// hyphens, starting and ending with an alphanumeric character.
+var indexNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// validIndexName reports whether name can be used as an index name.
+func validIndexName(name string) bool {
+	return len(name) <= maxIndexNameLength && indexNamePattern.MatchString(name)
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ValidIndexNameFunction{}
+
+func NewValidIndexNameFunction() function.Function {
+	return &ValidIndexNameFunction{}
+}
+
+// ValidIndexNameFunction defines the function implementation.
+type ValidIndexNameFunction struct{}
+
+func (f *ValidIndexNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "valid_index_name"
+}
+
+func (f *ValidIndexNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether a string is a valid index name",
+		MarkdownDescription: "Returns `true` when the name can be used as the name of a `pinecone_index`: at most 45 characters, " +
+			"made of lowercase alphanumeric characters or '-', and starting and ending with an alphanumeric character.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *ValidIndexNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, validIndexName(name)))
+}
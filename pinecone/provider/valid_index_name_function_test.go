@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestValidIndexName(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "my-index", want: true},
+		{name: "a", want: true},
+		{name: "index-2024", want: true},
+		{name: strings.Repeat("a", 45), want: true},
+		{name: strings.Repeat("a", 46), want: false},
+		{name: "", want: false},
+		{name: "My-Index", want: false},
+		{name: "my_index", want: false},
+		{name: "-my-index", want: false},
+		{name: "my-index-", want: false},
+		{name: "my.index", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := validIndexName(tt.name); got != tt.want {
+			t.Errorf("validIndexName(%q) = %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestValidIndexNameFunction(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "valid" {
+  value = provider::pinecone::valid_index_name("my-index")
+}
+
+output "invalid" {
+  value = provider::pinecone::valid_index_name("My_Index")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("valid", "true"),
+					resource.TestCheckOutput("invalid", "false"),
+				),
+			},
+		},
+	})
+}